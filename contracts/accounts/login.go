@@ -12,3 +12,12 @@ func ValidateMethod(method string) error {
 	}
 	return nil
 }
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+type LoginResponse struct {
+	Token string `json:"jwt"`
+}
@@ -1,8 +1,10 @@
 package accounts
 
 import (
+	"errors"
 	"time"
 
+	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/models"
 )
 
@@ -21,27 +23,59 @@ type FinishRegisterRequest struct {
 	DisplayName     string            `json:"display_name" binding:"required"`
 	IsMale          *bool             `json:"is_male" binding:"required"`
 	BirthDate       time.Time         `json:"birth_date" binding:"required"`
-	Height          uint              `json:"height" binding:"required"`
-	Weight          uint              `json:"weight" binding:"required"`
+	Height          float64           `json:"height" binding:"required"`
+	Weight          float64           `json:"weight" binding:"required"`
+	Units           string            `json:"units"`
 	Latitude        *float64          `json:"latitude" binding:"required"`
 	Longitude       *float64          `json:"longitude" binding:"required"`
 	InterestStrings []string          `json:"interests"`
 	Interests       []models.Interest `json:"-"`
 	Method          string            `json:"method"`
+	Language        string            `json:"language"`
 }
 
 func (req *FinishRegisterRequest) Validate() error {
-	interests, err := models.ValidateInterests(req.InterestStrings...)
-	if err != nil {
-		return err
+	validationErr := &contracts.ValidationError{}
+	validationErr.Add("nickname", contracts.CheckNickname(req.Nickname))
+	validationErr.Add("display_name", contracts.CheckDisplayName(req.DisplayName))
+	validationErr.Add("birth_date", contracts.CheckBirthDate(req.BirthDate, time.Now()))
+	if req.Latitude != nil {
+		validationErr.Add("latitude", contracts.CheckLatitude(*req.Latitude))
 	}
-	err = ValidateMethod(req.Method)
-	if err != nil {
-		return err
+	if req.Longitude != nil {
+		validationErr.Add("longitude", contracts.CheckLongitude(*req.Longitude))
+	}
+
+	if ValidateMethod(req.Method) != nil {
+		validationErr.Add("method", "invalid method")
+	}
+
+	if req.Language == "" {
+		req.Language = models.DefaultLanguage
+	}
+	if !models.IsValidLanguage(req.Language) {
+		validationErr.Add("language", "invalid language")
 	}
 
+	if req.Units == "" {
+		req.Units = models.DefaultUnits
+	}
+	if !models.IsValidUnits(req.Units) {
+		validationErr.Add("units", "invalid units")
+	} else {
+		validationErr.Add("height", models.CheckHeight(req.Height, req.Units))
+		validationErr.Add("weight", models.CheckWeight(req.Weight, req.Units))
+	}
+
+	interests, err := models.ValidateInterests(req.InterestStrings...)
+	if errors.Is(err, contracts.ErrInvalidInterest) {
+		validationErr.Add("interests", err.Error())
+	} else if err != nil {
+		return err
+	}
 	req.Interests = interests
-	return nil
+
+	return validationErr.Err()
 }
 
 type FinishRegisterResponse struct {
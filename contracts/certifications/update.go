@@ -1,6 +1,79 @@
 package certifications
 
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+)
+
+const MaxReviewerNotesLength = 500
+const MaxValidityDays = 10 * 365
+
 type UpdateCertificationRequest struct {
-	CertificationID uint
-	Status          string `form:"status" binding:"required"`
+	CertificationID  uint   `json:"-"`
+	Status           string `form:"status" json:"status"`
+	DenialReason     string `form:"denial_reason" json:"denial_reason"`
+	RevocationReason string `form:"revocation_reason" json:"revocation_reason"`
+	Notes            string `form:"notes" json:"notes"`
+	ReviewerID       uint   `form:"reviewer_id" json:"reviewer_id"`
+	ValidityDays     int    `form:"validity_days" json:"validity_days"`
+}
+
+// reviewStatuses are the statuses administrators can move certifications to, the rest are set by their users.
+var reviewStatuses = map[string]struct{}{
+	models.CertificationStatusInReview: {},
+	models.CertificationStatusApproved: {},
+	models.CertificationStatusDenied:   {},
+	models.CertificationStatusRevoked:  {},
+}
+
+// Validate requires a reviewer, a reason from models.CertificationDenialReasons for denials and one from
+// models.CertificationRevocationReasons for revocations. Other reasons must be explained in the notes. Approvals can
+// set how many days the certification stays valid, without one it never expires.
+func (req UpdateCertificationRequest) Validate() error {
+	validationErr := &contracts.ValidationError{}
+	if _, ok := reviewStatuses[req.Status]; !ok {
+		validationErr.Add("status", "invalid status")
+	}
+
+	if req.ReviewerID == 0 {
+		validationErr.Add("reviewer_id", "is required")
+	}
+
+	if req.Status == models.CertificationStatusDenied {
+		if _, ok := models.CertificationDenialReasons[req.DenialReason]; !ok {
+			validationErr.Add("denial_reason", "invalid denial reason")
+		}
+		if req.DenialReason == models.CertificationDenialOther && req.Notes == "" {
+			validationErr.Add("notes", "is required for other denial reasons")
+		}
+	} else if req.DenialReason != "" {
+		validationErr.Add("denial_reason", "is only allowed for denials")
+	}
+
+	if req.Status == models.CertificationStatusRevoked {
+		if _, ok := models.CertificationRevocationReasons[req.RevocationReason]; !ok {
+			validationErr.Add("revocation_reason", "invalid revocation reason")
+		}
+		if req.RevocationReason == models.CertificationRevocationOther && req.Notes == "" {
+			validationErr.Add("notes", "is required for other revocation reasons")
+		}
+	} else if req.RevocationReason != "" {
+		validationErr.Add("revocation_reason", "is only allowed for revocations")
+	}
+
+	if req.Status == models.CertificationStatusApproved {
+		if req.ValidityDays < 0 || req.ValidityDays > MaxValidityDays {
+			validationErr.Add("validity_days", fmt.Sprintf("must be between 0 and %d, 0 never expires", MaxValidityDays))
+		}
+	} else if req.ValidityDays != 0 {
+		validationErr.Add("validity_days", "is only allowed for approvals")
+	}
+
+	if utf8.RuneCountInString(req.Notes) > MaxReviewerNotesLength {
+		validationErr.Add("notes", fmt.Sprintf("must be at most %d characters long", MaxReviewerNotesLength))
+	}
+	return validationErr.Err()
 }
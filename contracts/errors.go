@@ -22,6 +22,24 @@ var (
 	ErrUserAlreadyCertified   = errors.New("user is already verified")
 	ErrPendingCertsExists     = errors.New("A pending certification request already exists")
 	ErrCertificationNotFound  = errors.New("certification not found")
+	ErrInterestNotFound       = errors.New("interest not found")
+	ErrInterestAlreadyExists  = errors.New("interest already exists")
+	ErrCannotFollowSelf       = errors.New("users can't follow themselves")
+	ErrAlreadyFollowing       = errors.New("user is already being followed")
+	ErrNotFollowing           = errors.New("user is not being followed")
+	ErrUserNotVerifiedTrainer = errors.New("user is not a verified trainer")
+	ErrTrainerProfileNotFound = errors.New("trainer profile not found")
+	ErrReviewerNotFollowing   = errors.New("only followers can review a trainer")
+	ErrReviewAlreadyExists    = errors.New("user already reviewed this trainer")
+	ErrReviewNotFound         = errors.New("review not found")
+	ErrNicknameOnHold         = errors.New("nickname was recently released by another user")
+	ErrNicknameChangeTooSoon  = errors.New("nickname was changed too recently")
+	ErrBlobNotFound           = errors.New("file not found")
+	ErrInvalidBlobSignature   = errors.New("invalid or expired file signature")
+	ErrLoginNotSupported      = errors.New("login is handled by the identity provider's client")
+	ErrUserDisabled           = errors.New("user is disabled")
+	ErrReviewerNotFound       = errors.New("reviewer not found")
+	ErrInvalidCertTransition  = errors.New("certification can't move to the requested status")
 )
 
 func HandleErrorType(ctx *gin.Context, err error) {
@@ -30,6 +48,8 @@ func HandleErrorType(ctx *gin.Context, err error) {
 	switch {
 	case errors.Is(err, ErrBadRequest):
 		status = http.StatusBadRequest
+	case errors.As(err, new(*ValidationError)):
+		status = http.StatusBadRequest
 	case errors.Is(err, ErrUserNotFound):
 		status = http.StatusNotFound
 	case errors.Is(err, ErrUserAlreadyExists):
@@ -50,6 +70,42 @@ func HandleErrorType(ctx *gin.Context, err error) {
 		status = http.StatusConflict
 	case errors.Is(err, ErrCertificationNotFound):
 		status = http.StatusNotFound
+	case errors.Is(err, ErrInterestNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrInterestAlreadyExists):
+		status = http.StatusConflict
+	case errors.Is(err, ErrCannotFollowSelf):
+		status = http.StatusBadRequest
+	case errors.Is(err, ErrAlreadyFollowing):
+		status = http.StatusConflict
+	case errors.Is(err, ErrNotFollowing):
+		status = http.StatusConflict
+	case errors.Is(err, ErrUserNotVerifiedTrainer):
+		status = http.StatusForbidden
+	case errors.Is(err, ErrTrainerProfileNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrReviewerNotFollowing):
+		status = http.StatusForbidden
+	case errors.Is(err, ErrReviewAlreadyExists):
+		status = http.StatusConflict
+	case errors.Is(err, ErrReviewNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrNicknameOnHold):
+		status = http.StatusConflict
+	case errors.Is(err, ErrNicknameChangeTooSoon):
+		status = http.StatusTooManyRequests
+	case errors.Is(err, ErrBlobNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrInvalidBlobSignature):
+		status = http.StatusForbidden
+	case errors.Is(err, ErrLoginNotSupported):
+		status = http.StatusNotImplemented
+	case errors.Is(err, ErrUserDisabled):
+		status = http.StatusForbidden
+	case errors.Is(err, ErrReviewerNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrInvalidCertTransition):
+		status = http.StatusConflict
 	case errors.Is(err, ErrVerificationPinExpired):
 		status = http.StatusUnauthorized
 	case errors.Is(err, ErrInvalidVerificationPin):
@@ -0,0 +1,36 @@
+package interests
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/fiufit/users/models"
+)
+
+type CreateInterestRequest struct {
+	Name         string            `json:"name" binding:"required"`
+	DisplayName  string            `json:"display_name" binding:"required"`
+	IconKey      string            `json:"icon_key"`
+	Translations map[string]string `json:"translations"`
+}
+
+func (req *CreateInterestRequest) Validate() error {
+	req.Name = NormalizeName(req.Name)
+	if req.Name == "" {
+		return errors.New("invalid interest name")
+	}
+	return ValidateTranslations(req.Translations)
+}
+
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func ValidateTranslations(translations map[string]string) error {
+	for language, displayName := range translations {
+		if !models.IsValidLanguage(language) || displayName == "" {
+			return errors.New("invalid interest translation")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package interests
+
+import (
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+)
+
+type GetInterestsRequest struct {
+	IncludeInactive bool `form:"include_inactive"`
+	contracts.Pagination
+}
+
+type GetInterestsResponse struct {
+	Interests []models.Interest `json:"interests"`
+	contracts.Pagination
+}
@@ -0,0 +1,13 @@
+package interests
+
+type UpdateInterestRequest struct {
+	Name         string
+	DisplayName  *string           `json:"display_name"`
+	IconKey      *string           `json:"icon_key"`
+	Active       *bool             `json:"active"`
+	Translations map[string]string `json:"translations"`
+}
+
+func (req *UpdateInterestRequest) Validate() error {
+	return ValidateTranslations(req.Translations)
+}
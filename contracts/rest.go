@@ -1,5 +1,9 @@
 package contracts
 
+import "errors"
+
+// errCodes never reuse a code. U23 belonged to the retired ErrInvalidBodyData, body data errors are reported as a
+// ValidationError now.
 var errCodes = map[error]string{
 	ErrInternal:               "U0",
 	ErrBadRequest:             "U1",
@@ -14,6 +18,24 @@ var errCodes = map[error]string{
 	ErrPendingCertsExists:     "U10",
 	ErrUserAlreadyCertified:   "U11",
 	ErrCertificationNotFound:  "U12",
+	ErrInterestNotFound:       "U13",
+	ErrInterestAlreadyExists:  "U14",
+	ErrCannotFollowSelf:       "U15",
+	ErrAlreadyFollowing:       "U16",
+	ErrNotFollowing:           "U17",
+	ErrUserNotVerifiedTrainer: "U18",
+	ErrTrainerProfileNotFound: "U19",
+	ErrReviewerNotFollowing:   "U20",
+	ErrReviewAlreadyExists:    "U21",
+	ErrReviewNotFound:         "U22",
+	ErrNicknameChangeTooSoon:  "U24",
+	ErrBlobNotFound:           "U25",
+	ErrInvalidBlobSignature:   "U26",
+	ErrLoginNotSupported:      "U27",
+	ErrUserDisabled:           "U28",
+	ErrReviewerNotFound:       "U29",
+	ErrInvalidCertTransition:  "U30",
+	ErrNicknameOnHold:         "U31",
 }
 
 var externalCodes = map[string]error{}
@@ -27,8 +49,9 @@ type ErrResponse struct {
 }
 
 type ErrPayload struct {
-	Code        string `json:"code"`
-	Description string `json:"description"`
+	Code        string       `json:"code"`
+	Description string       `json:"description"`
+	Fields      []FieldError `json:"fields,omitempty"`
 }
 
 func FormatOkResponse(data interface{}) OkResponse {
@@ -36,6 +59,16 @@ func FormatOkResponse(data interface{}) OkResponse {
 }
 
 func FormatErrResponse(err error) ErrResponse {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		payload := ErrPayload{
+			Description: ErrBadRequest.Error(),
+			Code:        errCodes[ErrBadRequest],
+			Fields:      validationErr.Fields,
+		}
+		return ErrResponse{payload}
+	}
+
 	errCode, ok := errCodes[err]
 	if !ok {
 		errCode = "U0"
@@ -48,3 +81,12 @@ func FormatErrResponse(err error) ErrResponse {
 
 	return ErrResponse{payload}
 }
+
+// FormatValidationErrResponse keeps the field details of a ValidationError, any other error is reported as ErrBadRequest.
+func FormatValidationErrResponse(err error) ErrResponse {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return FormatErrResponse(validationErr)
+	}
+	return FormatErrResponse(ErrBadRequest)
+}
@@ -0,0 +1,78 @@
+package trainers
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+
+	"github.com/fiufit/users/models"
+)
+
+const (
+	maxBioLength          = 1000
+	maxAvailabilityLength = 255
+	maxSpecialties        = 10
+	maxYearsOfExperience  = 80
+)
+
+var ValidLinkKinds = map[string]bool{
+	"website":   true,
+	"email":     true,
+	"phone":     true,
+	"instagram": true,
+	"facebook":  true,
+	"twitter":   true,
+	"youtube":   true,
+	"tiktok":    true,
+}
+
+var languageCodeRegex = regexp.MustCompile("^[a-z]{2}$")
+
+type GetTrainerProfileRequest struct {
+	UserID string
+}
+
+// UpdateTrainerProfileRequest replaces the whole trainer profile of UserID.
+type UpdateTrainerProfileRequest struct {
+	UserID            string            `json:"-"`
+	Bio               string            `json:"bio"`
+	SpecialtyStrings  []string          `json:"specialties"`
+	Specialties       []models.Interest `json:"-"`
+	YearsOfExperience uint              `json:"years_of_experience"`
+	Languages         []string          `json:"languages"`
+	Links             map[string]string `json:"links"`
+	Availability      string            `json:"availability"`
+}
+
+func (req *UpdateTrainerProfileRequest) Validate() error {
+	if len(req.Bio) > maxBioLength || len(req.Availability) > maxAvailabilityLength {
+		return errors.New("bio or availability too long")
+	}
+
+	if req.YearsOfExperience > maxYearsOfExperience {
+		return errors.New("invalid years of experience")
+	}
+
+	for _, language := range req.Languages {
+		if !languageCodeRegex.MatchString(language) {
+			return errors.New("invalid language code")
+		}
+	}
+
+	for kind, link := range req.Links {
+		parsedLink, err := url.Parse(link)
+		if !ValidLinkKinds[kind] || err != nil || parsedLink.Scheme == "" {
+			return errors.New("invalid trainer link")
+		}
+	}
+
+	if len(req.SpecialtyStrings) > maxSpecialties {
+		return errors.New("too many specialties")
+	}
+	specialties, err := models.ValidateInterests(req.SpecialtyStrings...)
+	if err != nil {
+		return err
+	}
+	req.Specialties = specialties
+	return nil
+}
@@ -0,0 +1,56 @@
+package trainers
+
+import (
+	"errors"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+)
+
+const (
+	MinRating       = 1
+	MaxRating       = 5
+	maxReviewLength = 500
+)
+
+type CreateReviewRequest struct {
+	TrainerID  string `json:"-"`
+	ReviewerID string `json:"reviewer_id" binding:"required"`
+	Rating     uint   `json:"rating" binding:"required"`
+	Review     string `json:"review"`
+}
+
+func (req *CreateReviewRequest) Validate() error {
+	return validateReview(&req.Rating, &req.Review)
+}
+
+type UpdateReviewRequest struct {
+	TrainerID  string  `json:"-"`
+	ReviewerID string  `json:"-"`
+	Rating     *uint   `json:"rating"`
+	Review     *string `json:"review"`
+}
+
+func (req *UpdateReviewRequest) Validate() error {
+	return validateReview(req.Rating, req.Review)
+}
+
+type GetReviewsRequest struct {
+	TrainerID string
+	contracts.Pagination
+}
+
+type GetReviewsResponse struct {
+	Reviews []models.TrainerReview `json:"reviews"`
+	contracts.Pagination
+}
+
+func validateReview(rating *uint, review *string) error {
+	if rating != nil && (*rating < MinRating || *rating > MaxRating) {
+		return errors.New("invalid rating")
+	}
+	if review != nil && len(*review) > maxReviewLength {
+		return errors.New("review too long")
+	}
+	return nil
+}
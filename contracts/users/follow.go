@@ -1,13 +1,26 @@
 package users
 
 import (
+	"errors"
+	"time"
+
 	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/models"
 )
 
+const (
+	FollowOrderNewest = "newest"
+	FollowOrderOldest = "oldest"
+)
+
 type FollowUserRequest struct {
 	FollowedUserID string
 	FollowerUserID string `form:"follower_id" binding:"required"`
+	Source         string `form:"source"`
+}
+
+func (req *FollowUserRequest) Validate() error {
+	return validateFollowSource(&req.Source)
 }
 
 type UnfollowUserRequest struct {
@@ -15,13 +28,35 @@ type UnfollowUserRequest struct {
 	FollowerUserID string `uri:"followerID" binding:"required"`
 }
 
+// GetUserFollowersRequest lists follows sorted by follow date, optionally only those made between From and To.
 type GetUserFollowersRequest struct {
 	UserID string
+	Order  string     `form:"order"`
+	From   *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To     *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
 	contracts.Pagination
 }
 
+func (req *GetUserFollowersRequest) Validate() error {
+	if req.Order == "" {
+		req.Order = FollowOrderNewest
+	}
+	if req.Order != FollowOrderNewest && req.Order != FollowOrderOldest {
+		return errors.New("invalid order")
+	}
+	if req.From != nil && req.To != nil && req.From.After(*req.To) {
+		return errors.New("invalid date range")
+	}
+	req.Pagination.Validate()
+	return nil
+}
+
 type GetFollowedUsersRequest GetUserFollowersRequest
 
+func (req *GetFollowedUsersRequest) Validate() error {
+	return (*GetUserFollowersRequest)(req).Validate()
+}
+
 type GetUserFollowersResponse struct {
 	contracts.Pagination
 	Followers []models.User `json:"followers"`
@@ -31,3 +66,60 @@ type GetFollowedUsersResponse struct {
 	contracts.Pagination
 	Followed []models.User `json:"followed"`
 }
+
+const maxBatchFollowSize = 100
+
+const (
+	BatchFollowStatusFollowed        = "followed"
+	BatchFollowStatusUnfollowed      = "unfollowed"
+	BatchFollowStatusAlreadyFollowed = "already_following"
+	BatchFollowStatusNotFollowed     = "not_following"
+	BatchFollowStatusNotFound        = "not_found"
+	BatchFollowStatusSelf            = "cannot_follow_self"
+)
+
+type BatchFollowRequest struct {
+	FollowerUserID string   `json:"-"`
+	Follow         []string `json:"follow"`
+	Unfollow       []string `json:"unfollow"`
+	Source         string   `json:"source"`
+}
+
+func (req *BatchFollowRequest) Validate() error {
+	if err := validateFollowSource(&req.Source); err != nil {
+		return err
+	}
+
+	total := len(req.Follow) + len(req.Unfollow)
+	if total == 0 || total > maxBatchFollowSize {
+		return errors.New("invalid batch size")
+	}
+
+	seen := make(map[string]bool, total)
+	for _, userID := range append(append([]string{}, req.Follow...), req.Unfollow...) {
+		if userID == "" || seen[userID] {
+			return errors.New("empty or repeated userID in batch")
+		}
+		seen[userID] = true
+	}
+	return nil
+}
+
+type BatchFollowResult struct {
+	UserID string `json:"user_id"`
+	Status string `json:"status"`
+}
+
+type BatchFollowResponse struct {
+	Results []BatchFollowResult `json:"results"`
+}
+
+func validateFollowSource(source *string) error {
+	if *source == "" {
+		*source = models.FollowSourceUnknown
+	}
+	if !models.ValidFollowSources[*source] {
+		return errors.New("invalid follow source")
+	}
+	return nil
+}
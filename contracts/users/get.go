@@ -11,6 +11,11 @@ type GetUsersRequest struct {
 	IsVerified *bool    `form:"is_verified"`
 	Disabled   *bool    `form:"disabled"`
 	UserIDs    []string `form:"user_ids[]"`
+
+	// Trainer filters, setting any of them only returns verified trainers.
+	Specialty       string `form:"specialty"`
+	MinExperience   *uint  `form:"min_experience"`
+	TrainerLanguage string `form:"trainer_language"`
 	contracts.Pagination
 }
 
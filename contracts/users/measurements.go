@@ -0,0 +1,53 @@
+package users
+
+import (
+	"errors"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+)
+
+const (
+	MeasurementsGroupByWeek  = "week"
+	MeasurementsGroupByMonth = "month"
+)
+
+// GetMeasurementsRequest lists the measurements of a user taken between From and To, newest first. When GroupBy
+// is set, per week or month aggregates of the whole range are returned as well.
+type GetMeasurementsRequest struct {
+	UserID  string
+	From    *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To      *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	GroupBy string     `form:"group_by"`
+	contracts.Pagination
+}
+
+func (req *GetMeasurementsRequest) Validate() error {
+	if req.GroupBy != "" && req.GroupBy != MeasurementsGroupByWeek && req.GroupBy != MeasurementsGroupByMonth {
+		return errors.New("invalid group_by")
+	}
+	if req.From != nil && req.To != nil && req.From.After(*req.To) {
+		return errors.New("invalid date range")
+	}
+	req.Pagination.Validate()
+	return nil
+}
+
+type MeasurementAggregate struct {
+	Period    time.Time `json:"period"`
+	Count     int64     `json:"count"`
+	MinWeight float64   `json:"min_weight"`
+	MaxWeight float64   `json:"max_weight"`
+	AvgWeight float64   `json:"avg_weight"`
+	MinHeight float64   `json:"min_height"`
+	MaxHeight float64   `json:"max_height"`
+	AvgHeight float64   `json:"avg_height"`
+	AvgBMI    float64   `json:"avg_bmi"`
+}
+
+type GetMeasurementsResponse struct {
+	Measurements []models.Measurement   `json:"measurements"`
+	Aggregates   []MeasurementAggregate `json:"aggregates,omitempty"`
+	contracts.Pagination
+}
@@ -0,0 +1,14 @@
+package users
+
+import "github.com/fiufit/users/contracts"
+
+type ForceRenameRequest struct {
+	UserID   string `json:"-"`
+	Nickname string `json:"nickname" binding:"required"`
+}
+
+func (req ForceRenameRequest) Validate() error {
+	validationErr := &contracts.ValidationError{}
+	validationErr.Add("nickname", contracts.CheckNickname(req.Nickname))
+	return validationErr.Err()
+}
@@ -0,0 +1,33 @@
+package users
+
+import (
+	"fmt"
+
+	"github.com/fiufit/users/contracts"
+)
+
+const MaxPictureBytes = 5 << 20
+
+// MaxPictureRequestBytes leaves room for the multipart headers and boundaries around the picture.
+const MaxPictureRequestBytes = MaxPictureBytes + 64<<10
+
+type UpdatePictureRequest struct {
+	UserID  string
+	Picture []byte
+}
+
+func (req UpdatePictureRequest) Validate() error {
+	validationErr := &contracts.ValidationError{}
+	if len(req.Picture) == 0 {
+		validationErr.Add("picture", "is required")
+	}
+	if len(req.Picture) > MaxPictureBytes {
+		validationErr.Add("picture", PictureTooBigReason())
+	}
+	return validationErr.Err()
+}
+
+// PictureTooBigReason describes pictures over MaxPictureBytes, also used for requests cut before the picture is read.
+func PictureTooBigReason() string {
+	return fmt.Sprintf("must be at most %d MB", MaxPictureBytes>>20)
+}
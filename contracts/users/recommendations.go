@@ -0,0 +1,64 @@
+package users
+
+import (
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+)
+
+const DefaultRecommendationDistance = 50
+
+type GetUserRecommendationsRequest struct {
+	UserID      string
+	MaxDistance uint `form:"max_distance"`
+	contracts.Pagination
+}
+
+// RecommendationScore explains how a suggestion was ranked. Total is the sum of every *Score field.
+type RecommendationScore struct {
+	Total                float64 `json:"total"`
+	SharedInterests      int64   `json:"shared_interests"`
+	InterestsScore       float64 `json:"interests_score"`
+	DistanceKm           float64 `json:"distance_km"`
+	ProximityScore       float64 `json:"proximity_score"`
+	MutualFollowers      int64   `json:"mutual_followers"`
+	MutualFollowersScore float64 `json:"mutual_followers_score"`
+	IsVerifiedTrainer    bool    `json:"is_verified_trainer"`
+	TrainerScore         float64 `json:"trainer_score"`
+}
+
+type UserRecommendation struct {
+	User  models.User         `json:"user"`
+	Score RecommendationScore `json:"score"`
+}
+
+type GetUserRecommendationsResponse struct {
+	Recommendations []UserRecommendation `json:"recommendations"`
+	contracts.Pagination
+}
+
+const FollowSuggestionPreviewSize = 3
+
+type GetFollowSuggestionsRequest struct {
+	UserID string
+	contracts.Pagination
+}
+
+type UserPreview struct {
+	ID          string `json:"id"`
+	Nickname    string `json:"nickname"`
+	DisplayName string `json:"display_name"`
+	PictureUrl  string `json:"picture_url"`
+}
+
+// FollowSuggestion is a user followed by MutualCount of the users the requester follows, some of which are
+// listed in MutualPreviews.
+type FollowSuggestion struct {
+	User           models.User   `json:"user"`
+	MutualCount    int64         `json:"mutual_count"`
+	MutualPreviews []UserPreview `json:"mutual_previews"`
+}
+
+type GetFollowSuggestionsResponse struct {
+	Suggestions []FollowSuggestion `json:"suggestions"`
+	contracts.Pagination
+}
@@ -0,0 +1,5 @@
+package users
+
+type ReconcileFollowCountsResponse struct {
+	RepairedUsers int64 `json:"repaired_users"`
+}
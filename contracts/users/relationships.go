@@ -0,0 +1,52 @@
+package users
+
+import (
+	"errors"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+)
+
+const maxRelationshipsBatchSize = 100
+
+// Relationship describes how UserID relates to the requesting user. Follows means the requesting user follows
+// UserID, FollowedBy means UserID follows the requesting user, and Blocked means UserID was blocked by an
+// administrator, which disables their account.
+type Relationship struct {
+	UserID     string `json:"user_id"`
+	Follows    bool   `json:"follows"`
+	FollowedBy bool   `json:"followed_by"`
+	Mutual     bool   `json:"mutual"`
+	Blocked    bool   `json:"blocked"`
+}
+
+type GetRelationshipRequest struct {
+	UserID      string
+	OtherUserID string `uri:"otherUserID" binding:"required"`
+}
+
+type GetRelationshipsRequest struct {
+	UserID  string
+	UserIDs []string `form:"user_ids[]" binding:"required"`
+}
+
+func (req *GetRelationshipsRequest) Validate() error {
+	if len(req.UserIDs) == 0 || len(req.UserIDs) > maxRelationshipsBatchSize {
+		return errors.New("invalid user_ids batch size")
+	}
+	return nil
+}
+
+type GetRelationshipsResponse struct {
+	Relationships []Relationship `json:"relationships"`
+}
+
+type GetMutualsRequest struct {
+	UserID string
+	contracts.Pagination
+}
+
+type GetMutualsResponse struct {
+	contracts.Pagination
+	Mutuals []models.User `json:"mutuals"`
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/models"
 )
 
@@ -13,25 +14,63 @@ type UpdateUserRequest struct {
 	DisplayName     string            `json:"display_name" `
 	IsMale          *bool             `json:"is_male" `
 	BirthDate       time.Time         `json:"birth_date" `
-	Height          uint              `json:"height" `
-	Weight          uint              `json:"weight" `
+	Height          float64           `json:"height" `
+	Weight          float64           `json:"weight" `
+	Units           string            `json:"units"`
 	Latitude        *float64          `json:"latitude"`
 	Longitude       *float64          `json:"longitude"`
 	InterestStrings []string          `json:"interests"`
 	Interests       []models.Interest `json:"-"`
+	Language        string            `json:"language"`
 }
 
+// Validate checks the fields that are present. Height and weight ranges depend on the user's units, so they are
+// checked on update.
 func (req *UpdateUserRequest) Validate() error {
+	validationErr := &contracts.ValidationError{}
 	if req.Latitude != nil && req.Longitude == nil || req.Latitude == nil && req.Longitude != nil {
-		return errors.New("invalid latitude/longitude pair")
+		validationErr.Add("latitude", "latitude and longitude must be given together")
+	}
+	if req.Latitude != nil {
+		validationErr.Add("latitude", contracts.CheckLatitude(*req.Latitude))
+	}
+	if req.Longitude != nil {
+		validationErr.Add("longitude", contracts.CheckLongitude(*req.Longitude))
+	}
+
+	if req.Nickname != "" {
+		validationErr.Add("nickname", contracts.CheckNickname(req.Nickname))
+	}
+	if req.DisplayName != "" {
+		validationErr.Add("display_name", contracts.CheckDisplayName(req.DisplayName))
+	}
+	if !req.BirthDate.IsZero() {
+		validationErr.Add("birth_date", contracts.CheckBirthDate(req.BirthDate, time.Now()))
+	}
+
+	if req.Language != "" && !models.IsValidLanguage(req.Language) {
+		validationErr.Add("language", "invalid language")
+	}
+
+	if req.Units != "" && !models.IsValidUnits(req.Units) {
+		validationErr.Add("units", "invalid units")
+	}
+	if req.Height < 0 {
+		validationErr.Add("height", "must be positive")
+	}
+	if req.Weight < 0 {
+		validationErr.Add("weight", "must be positive")
 	}
 
 	interests, err := models.ValidateInterests(req.InterestStrings...)
-	if err != nil {
+	if errors.Is(err, contracts.ErrInvalidInterest) {
+		validationErr.Add("interests", err.Error())
+	} else if err != nil {
 		return err
 	}
 	req.Interests = interests
-	return nil
+
+	return validationErr.Err()
 }
 
 type UpdateUserResponse struct {
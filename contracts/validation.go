@@ -0,0 +1,101 @@
+package contracts
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MinUserAge           = 13
+	MaxUserAge           = 120
+	MinNicknameLength    = 3
+	MaxNicknameLength    = 30
+	MaxDisplayNameLength = 50
+)
+
+var nicknamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.]+$`)
+
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// ValidationError gathers every invalid field of a request, so clients can show all of them at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	reasons := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		reasons[i] = field.Field + ": " + field.Reason
+	}
+	return "invalid fields: " + strings.Join(reasons, ", ")
+}
+
+// Add records reason for field, empty reasons are ignored so that check results can be passed directly.
+func (e *ValidationError) Add(field string, reason string) {
+	if reason != "" {
+		e.Fields = append(e.Fields, FieldError{Field: field, Reason: reason})
+	}
+}
+
+// Err returns the ValidationError if any field was added, or nil.
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+func CheckNickname(nickname string) string {
+	length := utf8.RuneCountInString(nickname)
+	if length < MinNicknameLength || length > MaxNicknameLength {
+		return fmt.Sprintf("must be between %d and %d characters long", MinNicknameLength, MaxNicknameLength)
+	}
+	if !nicknamePattern.MatchString(nickname) {
+		return "can only contain letters, numbers, dots and underscores"
+	}
+	return ""
+}
+
+func CheckDisplayName(displayName string) string {
+	if strings.TrimSpace(displayName) == "" {
+		return "can't be blank"
+	}
+	if utf8.RuneCountInString(displayName) > MaxDisplayNameLength {
+		return fmt.Sprintf("must be at most %d characters long", MaxDisplayNameLength)
+	}
+	return ""
+}
+
+func CheckBirthDate(birthDate time.Time, now time.Time) string {
+	if birthDate.After(now) {
+		return "can't be in the future"
+	}
+	if birthDate.After(now.AddDate(-MinUserAge, 0, 0)) {
+		return fmt.Sprintf("users must be at least %d years old", MinUserAge)
+	}
+	// users turning MaxUserAge + 1 today are already too old
+	if !birthDate.After(now.AddDate(-MaxUserAge-1, 0, 0)) {
+		return fmt.Sprintf("users must be at most %d years old", MaxUserAge)
+	}
+	return ""
+}
+
+func CheckLatitude(latitude float64) string {
+	if latitude < -90 || latitude > 90 {
+		return "must be between -90 and 90"
+	}
+	return ""
+}
+
+func CheckLongitude(longitude float64) string {
+	if longitude < -180 || longitude > 180 {
+		return "must be between -180 and 180"
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+package contracts
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckNickname(t *testing.T) {
+	tests := []struct {
+		nickname string
+		valid    bool
+	}{
+		{"ab", false},
+		{"abc", true},
+		{strings.Repeat("a", 30), true},
+		{strings.Repeat("a", 31), false},
+		{"coach_ana.92", true},
+		{"coach ana", false},
+		{"coach-ana", false},
+		{"coach@ana", false},
+		{"entrenadoría", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		reason := CheckNickname(test.nickname)
+		assert.Equal(t, test.valid, reason == "", test.nickname)
+	}
+}
+
+func TestCheckDisplayName(t *testing.T) {
+	tests := []struct {
+		displayName string
+		valid       bool
+	}{
+		{"Ana", true},
+		{"", false},
+		{"   ", false},
+		{strings.Repeat("ñ", 50), true},
+		{strings.Repeat("ñ", 51), false},
+	}
+
+	for _, test := range tests {
+		reason := CheckDisplayName(test.displayName)
+		assert.Equal(t, test.valid, reason == "", test.displayName)
+	}
+}
+
+func TestCheckBirthDate(t *testing.T) {
+	now := time.Date(2023, time.June, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		birthDate time.Time
+		valid     bool
+	}{
+		{"future", now.AddDate(0, 0, 1), false},
+		{"12 years old", now.AddDate(-13, 0, 1), false},
+		{"13 years old", now.AddDate(-13, 0, 0), true},
+		{"120 years old", now.AddDate(-120, 0, 0), true},
+		{"120 years old, turning 121 tomorrow", now.AddDate(-121, 0, 1), true},
+		{"121 years old", now.AddDate(-121, 0, 0), false},
+	}
+
+	for _, test := range tests {
+		reason := CheckBirthDate(test.birthDate, now)
+		assert.Equal(t, test.valid, reason == "", test.name)
+	}
+}
+
+func TestCheckCoordinates(t *testing.T) {
+	tests := []struct {
+		latitude  float64
+		longitude float64
+		valid     bool
+	}{
+		{-34.6, -58.4, true},
+		{90, 180, true},
+		{-90, -180, true},
+		{90.01, 0, false},
+		{-90.01, 0, false},
+		{0, 180.01, false},
+		{0, -180.01, false},
+	}
+
+	for _, test := range tests {
+		validationErr := &ValidationError{}
+		validationErr.Add("latitude", CheckLatitude(test.latitude))
+		validationErr.Add("longitude", CheckLongitude(test.longitude))
+		assert.Equal(t, test.valid, validationErr.Err() == nil, "%v, %v", test.latitude, test.longitude)
+	}
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	validationErr := &ValidationError{}
+	validationErr.Add("nickname", "")
+	assert.NoError(t, validationErr.Err())
+
+	validationErr.Add("nickname", CheckNickname("ab"))
+	validationErr.Add("latitude", CheckLatitude(91))
+
+	res := FormatErrResponse(validationErr.Err())
+	assert.Equal(t, errCodes[ErrBadRequest], res.Err.Code)
+	assert.Len(t, res.Err.Fields, 2)
+	assert.Equal(t, "nickname", res.Err.Fields[0].Field)
+	assert.Equal(t, "latitude", res.Err.Fields[1].Field)
+}
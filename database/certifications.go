@@ -0,0 +1,25 @@
+package database
+
+import (
+	"github.com/fiufit/users/models"
+	"gorm.io/gorm"
+)
+
+// MigrateCertificationTransitions creates the certification history table. When it's first created, the history of
+// existing certifications starts with their current status, dated at their last update.
+func MigrateCertificationTransitions(db *gorm.DB) error {
+	backfill := !db.Migrator().HasTable(&models.CertificationTransition{})
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.CertificationTransition{}); err != nil {
+			return err
+		}
+		if !backfill {
+			return nil
+		}
+
+		return tx.Exec(`
+			INSERT INTO certification_transitions (certification_id, from_status, to_status, actor_type, created_at)
+			SELECT id, '', status, ?, updated_at FROM certifications WHERE deleted_at IS NULL`, models.CertificationActorSystem).Error
+	})
+}
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/fiufit/users/models"
+	"gorm.io/gorm"
+)
+
+// MigrateFollows upgrades user_followers to the models.Follow schema. Rows that predate the follow timestamps get
+// the creation date of the newest of both users, which is the earliest the follow could have happened.
+func MigrateFollows(db *gorm.DB) error {
+	backfill := db.Migrator().HasTable(&models.Follow{}) && !db.Migrator().HasColumn(&models.Follow{}, "CreatedAt")
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.Follow{}); err != nil {
+			return err
+		}
+		if !backfill {
+			return nil
+		}
+
+		return tx.Exec(`
+			UPDATE user_followers SET created_at = GREATEST(followed.created_at, follower.created_at)
+			FROM users followed, users follower
+			WHERE followed.id = user_followers.user_id AND follower.id = user_followers.follower_id`).Error
+	})
+}
@@ -0,0 +1,25 @@
+package database
+
+import (
+	"github.com/fiufit/users/models"
+	"gorm.io/gorm"
+)
+
+// MigrateMeasurements creates the measurements table. When it's first created, the history of existing users starts
+// with their current body data, dated at their registration.
+func MigrateMeasurements(db *gorm.DB) error {
+	backfill := !db.Migrator().HasTable(&models.Measurement{})
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.Measurement{}); err != nil {
+			return err
+		}
+		if !backfill {
+			return nil
+		}
+
+		return tx.Exec(`
+			INSERT INTO measurements (user_id, height, weight, measured_at)
+			SELECT id, height, weight, created_at FROM users WHERE deleted_at IS NULL`).Error
+	})
+}
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"github.com/fiufit/users/models"
+	"gorm.io/gorm"
+)
+
+// MigrateNicknames prepares users for the case insensitive nickname index. Users whose nickname only differs in
+// case from an older user's get the start of their ID appended, and must be run before migrating models.User.
+func MigrateNicknames(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&models.User{}) || db.Migrator().HasIndex(&models.User{}, "idx_users_nickname_lower") {
+		return nil
+	}
+
+	return db.Exec(`
+		UPDATE users SET nickname = users.nickname || '_' || LEFT(users.id, 6)
+		WHERE EXISTS (
+			SELECT 1 FROM users older
+			WHERE LOWER(older.nickname) = LOWER(users.nickname)
+			AND (older.created_at < users.created_at OR older.created_at = users.created_at AND older.id < users.id)
+		)`).Error
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type BatchFollow struct {
+	follows users.UserFollower
+	logger  *zap.Logger
+}
+
+func NewBatchFollow(follows users.UserFollower, logger *zap.Logger) BatchFollow {
+	return BatchFollow{follows: follows, logger: logger}
+}
+
+// Batch Follow godoc
+//
+//	@Summary		Follow and unfollow several users at once.
+//	@Description	Follows and unfollows up to 100 users in total on behalf of the user in the route, in a single transaction. Each target gets its own result status.
+//	@Tags			followers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version									path		string							true	"API Version"
+//	@Param			userID									path		string							true	"userID of the following user"
+//	@Param			payload									body		ucontracts.BatchFollowRequest	true	"userIDs to follow and to unfollow"
+//	@Success		200										{object}	users.BatchFollowResponse		"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400										{object}	contracts.ErrResponse
+//	@Failure		404										{object}	contracts.ErrResponse
+//	@Failure		500										{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/followed/batch [post]
+func (h BatchFollow) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.BatchFollowRequest
+		err := ctx.ShouldBindJSON(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.FollowerUserID = ctx.MustGet("userID").(string)
+
+		res, err := h.follows.BatchFollow(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
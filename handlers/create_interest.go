@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	iContracts "github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/usecases/interests"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateInterest struct {
+	interests interests.InterestCreator
+}
+
+func NewCreateInterest(interests interests.InterestCreator) CreateInterest {
+	return CreateInterest{interests: interests}
+}
+
+// Create Interest godoc
+//
+//	@Summary		Adds an interest to the catalog.
+//	@Description	Adds a new interest users can pick. The name is used as the interest key and is stored lowercased.
+//	@Tags			interests
+//	@Accept			json
+//	@Produce		json
+//	@Param			version						path		string								true	"API Version"
+//	@Param			payload						body		iContracts.CreateInterestRequest	true	"Body params"
+//	@Success		200							{object}	models.Interest						"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400							{object}	contracts.ErrResponse
+//	@Failure		409							{object}	contracts.ErrResponse
+//	@Failure		500							{object}	contracts.ErrResponse
+//	@Router			/{version}/admin/interests	[post]
+func (h CreateInterest) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req iContracts.CreateInterestRequest
+		err := ctx.ShouldBindJSON(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		interest, err := h.interests.Create(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(interest))
+	}
+}
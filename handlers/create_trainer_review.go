@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	tContracts "github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/usecases/trainers"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateTrainerReview struct {
+	reviews trainers.TrainerReviewer
+}
+
+func NewCreateTrainerReview(reviews trainers.TrainerReviewer) CreateTrainerReview {
+	return CreateTrainerReview{reviews: reviews}
+}
+
+// Create Trainer Review godoc
+//
+//	@Summary		Reviews a verified trainer.
+//	@Description	Rates a verified trainer from 1 to 5 stars with an optional short review. Only followers of the trainer can review them, once.
+//	@Tags			trainers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version								path		string							true	"API Version"
+//	@Param			userID								path		string							true	"userID of the trainer"
+//	@Param			payload								body		tContracts.CreateReviewRequest	true	"Body params"
+//	@Success		200									{object}	models.TrainerReview			"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400									{object}	contracts.ErrResponse
+//	@Failure		403									{object}	contracts.ErrResponse
+//	@Failure		404									{object}	contracts.ErrResponse
+//	@Failure		409									{object}	contracts.ErrResponse
+//	@Failure		500									{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/reviews	[post]
+func (h CreateTrainerReview) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req tContracts.CreateReviewRequest
+		err := ctx.ShouldBindJSON(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		req.TrainerID = ctx.MustGet("userID").(string)
+
+		review, err := h.reviews.Create(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(review))
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/usecases/interests"
+	"github.com/gin-gonic/gin"
+)
+
+type DeactivateInterest struct {
+	interests interests.InterestUpdater
+}
+
+func NewDeactivateInterest(interests interests.InterestUpdater) DeactivateInterest {
+	return DeactivateInterest{interests: interests}
+}
+
+// Deactivate Interest godoc
+//
+//	@Summary		Deactivates an interest of the catalog.
+//	@Description	Deactivates an interest so it can no longer be picked. Users that already have it keep it.
+//	@Tags			interests
+//	@Accept			json
+//	@Produce		json
+//	@Param			version										path		string			true	"API Version"
+//	@Param			interestName								path		string			true	"Interest name"
+//	@Success		200											{object}	models.Interest	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400											{object}	contracts.ErrResponse
+//	@Failure		404											{object}	contracts.ErrResponse
+//	@Failure		500											{object}	contracts.ErrResponse
+//	@Router			/{version}/admin/interests/{interestName}	[delete]
+func (h DeactivateInterest) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var name interestName
+		err := ctx.ShouldBindUri(&name)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		interest, err := h.interests.Deactivate(ctx, name.Name)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(interest))
+	}
+}
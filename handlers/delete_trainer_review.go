@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/usecases/trainers"
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteTrainerReview struct {
+	reviews trainers.TrainerReviewer
+}
+
+func NewDeleteTrainerReview(reviews trainers.TrainerReviewer) DeleteTrainerReview {
+	return DeleteTrainerReview{reviews: reviews}
+}
+
+type reviewID struct {
+	ReviewID uint `uri:"reviewID" binding:"required"`
+}
+
+// Delete Trainer Review godoc
+//
+//	@Summary		Removes a trainer review.
+//	@Description	Removes a review for moderation purposes. The reviewer can review the trainer again afterwards.
+//	@Tags			trainers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version								path		string	true	"API Version"
+//	@Param			reviewID							path		int		true	"ID of the review"
+//	@Success		200									{object}	string	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400									{object}	contracts.ErrResponse
+//	@Failure		404									{object}	contracts.ErrResponse
+//	@Failure		500									{object}	contracts.ErrResponse
+//	@Router			/{version}/admin/reviews/{reviewID}	[delete]
+func (h DeleteTrainerReview) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var id reviewID
+		err := ctx.ShouldBindUri(&id)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		err = h.reviews.Delete(ctx, id.ReviewID)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(""))
+	}
+}
@@ -21,7 +21,7 @@ func NewFinishRegister(users accounts.Registerer, logger *zap.Logger) FinishRegi
 
 // User Register godoc
 //	@Summary		Register a new user.
-//	@Description	Register a new User. Mandatory to be called after /users/register to complete additional profile info
+//	@Description	Register a new User. Mandatory to be called after /users/register to complete additional profile info. On 400, invalid fields are listed in error.fields.
 //	@Tags			accounts
 //	@Accept			json
 //	@Produce		json
@@ -36,11 +36,14 @@ func (h FinishRegister) Handle() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		var req ucontracts.FinishRegisterRequest
 		err := ctx.ShouldBindJSON(&req)
-		validateErr := req.Validate()
-		if err != nil || validateErr != nil {
+		if err != nil {
 			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
 			return
 		}
+		if err := req.Validate(); err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatValidationErrResponse(err))
+			return
+		}
 
 		userID := ctx.MustGet("userID").(string)
 		req.UserID = userID
@@ -50,6 +53,8 @@ func (h FinishRegister) Handle() gin.HandlerFunc {
 			contracts.HandleErrorType(ctx, err)
 			return
 		}
+		res.User.LocalizeOwn(ctx.GetString("language"))
+		res.User.ConvertUnits()
 
 		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
 	}
@@ -27,17 +27,20 @@ func NewFollowUser(follows users.UserFollower, logger *zap.Logger) FollowUser {
 //	@Produce		json
 //	@Param			version									path		string	true	"API Version"
 //	@Param			follower_id								query		string	true	"userID of the following user"
+//	@Param			source									query		string	false	"where the follow was made from: search, suggestion, profile or unknown (default)"
 //	@Param			userID									path		string	true	"userID of followed user"
 //	@Success		200										{object}	string	"Important Note: OK responses are wrapped in {"data": ... }"
 //	@Failure		400										{object}	contracts.ErrResponse
 //	@Failure		404										{object}	contracts.ErrResponse
+//	@Failure		409										{object}	contracts.ErrResponse
 //	@Failure		500										{object}	contracts.ErrResponse
 //	@Router			/{version}/users/{userID}/followers 	[post]
 func (h FollowUser) Handle() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		var req ucontracts.FollowUserRequest
 		err := ctx.ShouldBindQuery(&req)
-		if err != nil {
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
 			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
 			return
 		}
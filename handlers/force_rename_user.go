@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type ForceRenameUser struct {
+	users  users.UserRenamer
+	logger *zap.Logger
+}
+
+func NewForceRenameUser(users users.UserRenamer, logger *zap.Logger) ForceRenameUser {
+	return ForceRenameUser{users: users, logger: logger}
+}
+
+// Force Rename User godoc
+//
+//	@Summary		Replaces the nickname of a user.
+//	@Description	Replaces an offending nickname. Reserved and profane words are not checked. This endpoint should only be called by admins. Authorization is the gateway's responsibility.
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			version									path		string							true	"API Version"
+//	@Param			userID									path		string							true	"User ID"
+//	@Param			payload									body		ucontracts.ForceRenameRequest	true	"Body params"
+//	@Success		200										{object}	models.User						"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400										{object}	contracts.ErrResponse
+//	@Failure		404										{object}	contracts.ErrResponse
+//	@Failure		409										{object}	contracts.ErrResponse
+//	@Failure		500										{object}	contracts.ErrResponse
+//	@Router			/{version}/admin/users/{userID}/nickname	[put]
+func (h ForceRenameUser) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.ForceRenameRequest
+		err := ctx.ShouldBindJSON(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		if err := req.Validate(); err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatValidationErrResponse(err))
+			return
+		}
+		req.UserID = ctx.MustGet("userID").(string)
+
+		user, err := h.users.ForceRename(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(user))
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+	"path/filepath"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/repositories/external"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GetBlob struct {
+	blobs  external.LocalBlobStorage
+	logger *zap.Logger
+}
+
+func NewGetBlob(blobs external.LocalBlobStorage, logger *zap.Logger) GetBlob {
+	return GetBlob{blobs: blobs, logger: logger}
+}
+
+// Get Blob godoc
+//
+//	@Summary		Serves a file of the local blob storage.
+//	@Description	Serves pictures and videos when the service runs with BLOB_STORAGE=local. Only reachable through the signed URLs returned in other responses.
+//	@Tags			media
+//	@Produce		octet-stream
+//	@Param			version					path		string	true	"API Version"
+//	@Param			path					path		string	true	"File path"
+//	@Param			expires					query		int		true	"Signature expiration, as a unix timestamp"
+//	@Param			signature				query		string	true	"URL signature"
+//	@Success		200						{file}		file
+//	@Failure		403						{object}	contracts.ErrResponse
+//	@Failure		404						{object}	contracts.ErrResponse
+//	@Failure		500						{object}	contracts.ErrResponse
+//	@Router			/{version}/blobs/{path}	[get]
+func (h GetBlob) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		path := ctx.Param("path")
+		err := h.blobs.VerifySignature(http.MethodGet, path, ctx.Query("expires"), ctx.Query("signature"))
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		file, err := h.blobs.Open(path)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+			ctx.Header("Content-Type", contentType)
+		}
+		http.ServeContent(ctx.Writer, ctx.Request, info.Name(), info.ModTime(), file)
+	}
+}
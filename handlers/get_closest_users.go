@@ -5,6 +5,7 @@ import (
 
 	"github.com/fiufit/users/contracts"
 	uContracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/usecases/users"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -53,6 +54,7 @@ func (h GetClosestUsers) Handle() gin.HandlerFunc {
 			contracts.HandleErrorType(ctx, err)
 			return
 		}
+		models.LocalizeUsers(resUsers.Users, ctx.GetString("language"))
 		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(resUsers))
 	}
 }
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GetFollowSuggestions struct {
+	users  users.UserRecommender
+	logger *zap.Logger
+}
+
+func NewGetFollowSuggestions(users users.UserRecommender, logger *zap.Logger) GetFollowSuggestions {
+	return GetFollowSuggestions{users: users, logger: logger}
+}
+
+// Get Follow Suggestions godoc
+//
+//	@Summary		Gets users followed by the people a user follows.
+//	@Description	Gets the users followed by the people the user follows that the user doesn't follow yet, ranked by the number of mutual connections.
+//	@Tags			followers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version											path		string								true	"API Version"
+//	@Param			userID											path		string								true	"userID of the person whose suggestions we want to GET"
+//	@Param			page											query		int									false	"page number when getting with pagination"
+//	@Param			page_size										query		int									false	"page size when getting with pagination"
+//	@Success		200												{object}	users.GetFollowSuggestionsResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400												{object}	contracts.ErrResponse
+//	@Failure		404												{object}	contracts.ErrResponse
+//	@Failure		500												{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/follow-suggestions	[get]
+func (h GetFollowSuggestions) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.GetFollowSuggestionsRequest
+		err := ctx.ShouldBindQuery(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.UserID = ctx.MustGet("userID").(string)
+
+		req.Pagination.Validate()
+		res, err := h.users.GetFollowSuggestions(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		language := ctx.GetString("language")
+		for i := range res.Suggestions {
+			res.Suggestions[i].User.Localize(language)
+		}
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
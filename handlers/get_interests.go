@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	iContracts "github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/usecases/interests"
+	"github.com/gin-gonic/gin"
+)
+
+type GetInterests struct {
+	interests interests.InterestGetter
+}
+
+func NewGetInterests(interests interests.InterestGetter) GetInterests {
+	return GetInterests{interests: interests}
+}
+
+// Get Interests godoc
+//
+//	@Summary		Gets the interest catalog.
+//	@Description	Gets the interests users can pick, with pagination. Deactivated interests are only listed when include_inactive is set.
+//	@Tags			interests
+//	@Accept			json
+//	@Produce		json
+//	@Param			version						path		string							true	"API Version"
+//	@Param			include_inactive			query		bool							false	"also list deactivated interests"
+//	@Param			Accept-Language				header		string							false	"Language for interest labels"
+//	@Param			page						query		int								false	"page number when getting with pagination"
+//	@Param			page_size					query		int								false	"page size when getting with pagination"
+//	@Success		200							{object}	iContracts.GetInterestsResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400							{object}	contracts.ErrResponse
+//	@Failure		500							{object}	contracts.ErrResponse
+//	@Router			/{version}/users/interests	[get]
+func (h GetInterests) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req iContracts.GetInterestsRequest
+		err := ctx.ShouldBindQuery(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.Pagination.Validate()
+		res, err := h.interests.Get(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+		if language := ctx.GetString("language"); language != "" {
+			for i := range res.Interests {
+				res.Interests[i].Localize(language)
+			}
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GetMeasurements struct {
+	measurements users.UserMeasurements
+	logger       *zap.Logger
+}
+
+func NewGetMeasurements(measurements users.UserMeasurements, logger *zap.Logger) GetMeasurements {
+	return GetMeasurements{measurements: measurements, logger: logger}
+}
+
+// Get Measurements godoc
+//
+//	@Summary		Gets the body measurement history of a user.
+//	@Description	Gets the height and weight measurements of a user with their BMI, newest first. With group_by, per week or month aggregates of the date range are included.
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			version									path		string							true	"API Version"
+//	@Param			userID									path		string							true	"User ID"
+//	@Param			from									query		string							false	"only measurements taken at or after this RFC3339 date"
+//	@Param			to										query		string							false	"only measurements taken at or before this RFC3339 date"
+//	@Param			group_by								query		string							false	"week or month"
+//	@Param			page									query		int								false	"page number when getting with pagination"
+//	@Param			page_size								query		int								false	"page size when getting with pagination"
+//	@Success		200										{object}	users.GetMeasurementsResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400										{object}	contracts.ErrResponse
+//	@Failure		404										{object}	contracts.ErrResponse
+//	@Failure		500										{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/measurements	[get]
+func (h GetMeasurements) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.GetMeasurementsRequest
+		err := ctx.ShouldBindQuery(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.UserID = ctx.MustGet("userID").(string)
+
+		res, err := h.measurements.GetMeasurements(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GetMutuals struct {
+	relationships users.UserRelationships
+	logger        *zap.Logger
+}
+
+func NewGetMutuals(relationships users.UserRelationships, logger *zap.Logger) GetMutuals {
+	return GetMutuals{relationships: relationships, logger: logger}
+}
+
+// Get Mutuals godoc
+//
+//	@Summary		Gets the mutuals of a user.
+//	@Description	Gets the users that both follow and are followed by a user.
+//	@Tags			followers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version								path		string						true	"API Version"
+//	@Param			userID								path		string						true	"userID of the person whose mutuals we want to GET"
+//	@Param			page								query		int							false	"page number when getting with pagination"
+//	@Param			page_size							query		int							false	"page size when getting with pagination"
+//	@Success		200									{object}	users.GetMutualsResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400									{object}	contracts.ErrResponse
+//	@Failure		404									{object}	contracts.ErrResponse
+//	@Failure		500									{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/mutuals	[get]
+func (h GetMutuals) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.GetMutualsRequest
+		err := ctx.ShouldBindQuery(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.UserID = ctx.MustGet("userID").(string)
+
+		req.Pagination.Validate()
+		res, err := h.relationships.GetMutuals(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+		models.LocalizeUsers(res.Mutuals, ctx.GetString("language"))
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GetRelationship struct {
+	relationships users.UserRelationships
+	logger        *zap.Logger
+}
+
+func NewGetRelationship(relationships users.UserRelationships, logger *zap.Logger) GetRelationship {
+	return GetRelationship{relationships: relationships, logger: logger}
+}
+
+// Get Relationship godoc
+//
+//	@Summary		Gets the relationship between two users.
+//	@Description	Gets whether the user follows, is followed by or is mutual with another user, and whether the other user is blocked.
+//	@Tags			followers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version												path		string					true	"API Version"
+//	@Param			userID												path		string					true	"userID of the requesting user"
+//	@Param			otherUserID											path		string					true	"userID of the other user"
+//	@Success		200													{object}	users.Relationship		"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400													{object}	contracts.ErrResponse
+//	@Failure		404													{object}	contracts.ErrResponse
+//	@Failure		500													{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/relationships/{otherUserID}	[get]
+func (h GetRelationship) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.GetRelationshipRequest
+		err := ctx.ShouldBindUri(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.UserID = ctx.MustGet("userID").(string)
+
+		res, err := h.relationships.GetRelationship(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GetRelationships struct {
+	relationships users.UserRelationships
+	logger        *zap.Logger
+}
+
+func NewGetRelationships(relationships users.UserRelationships, logger *zap.Logger) GetRelationships {
+	return GetRelationships{relationships: relationships, logger: logger}
+}
+
+// Get Relationships godoc
+//
+//	@Summary		Gets the relationship between a user and a batch of users.
+//	@Description	Gets the relationship between a user and up to 100 other users in one call. Unknown userIDs are left out of the response.
+//	@Tags			followers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version									path		string							true	"API Version"
+//	@Param			userID									path		string							true	"userID of the requesting user"
+//	@Param			user_ids[]								query		[]string						true	"userIDs of the other users"
+//	@Success		200										{object}	users.GetRelationshipsResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400										{object}	contracts.ErrResponse
+//	@Failure		404										{object}	contracts.ErrResponse
+//	@Failure		500										{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/relationships	[get]
+func (h GetRelationships) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.GetRelationshipsRequest
+		err := ctx.ShouldBindQuery(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.UserID = ctx.MustGet("userID").(string)
+
+		res, err := h.relationships.GetRelationships(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
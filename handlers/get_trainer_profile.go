@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	tContracts "github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/usecases/trainers"
+	"github.com/gin-gonic/gin"
+)
+
+type GetTrainerProfile struct {
+	profiles trainers.TrainerProfileGetter
+}
+
+func NewGetTrainerProfile(profiles trainers.TrainerProfileGetter) GetTrainerProfile {
+	return GetTrainerProfile{profiles: profiles}
+}
+
+// Get Trainer Profile godoc
+//
+//	@Summary		Gets the profile of a verified trainer.
+//	@Description	Gets the bio, specialties, experience, languages, links, availability and approved certifications of a verified trainer.
+//	@Tags			trainers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version										path		string					true	"API Version"
+//	@Param			userID										path		string					true	"userID of the trainer"
+//	@Success		200											{object}	models.TrainerProfile	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		404											{object}	contracts.ErrResponse
+//	@Failure		500											{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/trainer-profile	[get]
+func (h GetTrainerProfile) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := tContracts.GetTrainerProfileRequest{UserID: ctx.MustGet("userID").(string)}
+
+		profile, err := h.profiles.Get(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		profile.Localize(ctx.GetString("language"))
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(profile))
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	tContracts "github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/usecases/trainers"
+	"github.com/gin-gonic/gin"
+)
+
+type GetTrainerReviews struct {
+	reviews trainers.TrainerReviewer
+}
+
+func NewGetTrainerReviews(reviews trainers.TrainerReviewer) GetTrainerReviews {
+	return GetTrainerReviews{reviews: reviews}
+}
+
+// Get Trainer Reviews godoc
+//
+//	@Summary		Gets the reviews of a trainer.
+//	@Description	Gets the reviews of a trainer, most recently updated first.
+//	@Tags			trainers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version								path		string							true	"API Version"
+//	@Param			userID								path		string							true	"userID of the trainer"
+//	@Param			page								query		int								false	"page number when getting with pagination"
+//	@Param			page_size							query		int								false	"page size when getting with pagination"
+//	@Success		200									{object}	tContracts.GetReviewsResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400									{object}	contracts.ErrResponse
+//	@Failure		404									{object}	contracts.ErrResponse
+//	@Failure		500									{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/reviews	[get]
+func (h GetTrainerReviews) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req tContracts.GetReviewsRequest
+		err := ctx.ShouldBindQuery(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		req.Pagination.Validate()
+		req.TrainerID = ctx.MustGet("userID").(string)
+
+		res, err := h.reviews.Get(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
@@ -27,6 +27,7 @@ func NewGetUserByID(users users.UserGetter, logger *zap.Logger) GetUserByID {
 //	@Produce		json
 //	@Param			version						path		string		true	"API Version"
 //	@Param			userID						path		string		true	"User ID"
+//	@Param			Accept-Language				header		string		false	"Language for interest labels, defaults to English"
 //	@Success		200							{object}	models.User	"Important Note: OK responses are wrapped in {"data": ... }"
 //	@Failure		400							{object}	contracts.ErrResponse
 //	@Failure		404							{object}	contracts.ErrResponse
@@ -40,6 +41,8 @@ func (h GetUserByID) Handle() gin.HandlerFunc {
 			contracts.HandleErrorType(ctx, err)
 			return
 		}
+		user.Localize(ctx.GetString("language"))
+		user.ConvertUnits()
 		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(user))
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/fiufit/users/contracts"
 	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/usecases/users"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -30,6 +31,9 @@ func NewGetUserFollowers(users users.UserGetter, logger *zap.Logger) GetUserFoll
 //	@Param			userID								path		string							true	"userID of the person whose followers we want to GET"
 //	@Param			page								query		int								false	"page number when getting with pagination"
 //	@Param			page_size							query		int								false	"page size when getting with pagination"
+//	@Param			order								query		string							false	"newest (default) or oldest, by follow date"
+//	@Param			from								query		string							false	"only follows made at or after this RFC3339 date"
+//	@Param			to									query		string							false	"only follows made at or before this RFC3339 date"
 //	@Success		200									{object}	users.GetUserFollowersResponse	"Important Note: OK responses are wrapped in {"data": ... }"
 //	@Failure		400									{object}	contracts.ErrResponse
 //	@Failure		404									{object}	contracts.ErrResponse
@@ -39,7 +43,8 @@ func (h GetUserFollowers) Handle() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		var req ucontracts.GetUserFollowersRequest
 		err := ctx.ShouldBindQuery(&req)
-		if err != nil {
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
 			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
 			return
 		}
@@ -51,6 +56,7 @@ func (h GetUserFollowers) Handle() gin.HandlerFunc {
 			contracts.HandleErrorType(ctx, err)
 			return
 		}
+		models.LocalizeUsers(res.Followers, ctx.GetString("language"))
 
 		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
 	}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type GetUserRecommendations struct {
+	users  users.UserRecommender
+	logger *zap.Logger
+}
+
+func NewGetUserRecommendations(users users.UserRecommender, logger *zap.Logger) GetUserRecommendations {
+	return GetUserRecommendations{users: users, logger: logger}
+}
+
+// Get User Recommendations godoc
+//
+//	@Summary		Gets users recommended to follow.
+//	@Description	Gets users the requesting user may like to train with, ranked by shared interests, proximity, mutual followers and verified-trainer status. Already followed and disabled users are excluded.
+//	@Tags			followers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version										path		string									true	"API Version"
+//	@Param			userID										path		string									true	"userID of the person whose recommendations we want to GET"
+//	@Param			max_distance								query		int										false	"distance (km) at which proximity stops adding to the score, defaults to 50"
+//	@Param			page										query		int										false	"page number when getting with pagination"
+//	@Param			page_size									query		int										false	"page size when getting with pagination"
+//	@Success		200											{object}	users.GetUserRecommendationsResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400											{object}	contracts.ErrResponse
+//	@Failure		404											{object}	contracts.ErrResponse
+//	@Failure		500											{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/recommendations	[get]
+func (h GetUserRecommendations) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.GetUserRecommendationsRequest
+		err := ctx.ShouldBindQuery(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req.UserID = ctx.MustGet("userID").(string)
+
+		req.Pagination.Validate()
+		res, err := h.users.GetRecommendations(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		language := ctx.GetString("language")
+		for i := range res.Recommendations {
+			res.Recommendations[i].User.Localize(language)
+		}
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/fiufit/users/contracts"
 	users2 "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/usecases/users"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -23,7 +24,7 @@ func NewGetUsers(users users.UserGetter, logger *zap.Logger) GetUsers {
 // Get Users godoc
 //
 //	@Summary		Gets users by different query params with pagination.
-//	@Description	Gets users by their name, nickname, location or verification status. If nickname has a value, other parameters are ignored.
+//	@Description	Gets users by their name, nickname, location or verification status. If nickname has a value, other parameters are ignored, and a nickname recently released by a user returns that user with nickname_redirect set.
 //	@Tags			accounts
 //	@Accept			json
 //	@Produce		json
@@ -32,6 +33,9 @@ func NewGetUsers(users users.UserGetter, logger *zap.Logger) GetUsers {
 //	@Param			name				query		string	false	"Substring that can be contained in either the User's Display Name or Nickname"
 //	@Param			location			query		string	false	"User Location"
 //	@Param			is_verified			query		string	false	"User verification status"
+//	@Param			specialty			query		string	false	"Trainer specialty, only returns verified trainers"
+//	@Param			min_experience		query		int		false	"Minimum trainer years of experience, only returns verified trainers"
+//	@Param			trainer_language	query		string	false	"Language code spoken by the trainer, only returns verified trainers"
 //	@Param			page				query		int		false	"page number when getting with pagination"
 //	@Param			page_size			query		int		false	"page size when getting with pagination"
 //	@Success		200					{object}	string	"Important Note: OK responses are wrapped in {"data": ... }"
@@ -58,6 +62,7 @@ func (h GetUsers) Handle() gin.HandlerFunc {
 				ctx.JSON(http.StatusInternalServerError, contracts.FormatErrResponse(contracts.ErrInternal))
 				return
 			}
+			user.Localize(ctx.GetString("language"))
 			ctx.JSON(http.StatusOK, contracts.FormatOkResponse(user))
 			return
 		}
@@ -68,6 +73,7 @@ func (h GetUsers) Handle() gin.HandlerFunc {
 			ctx.JSON(http.StatusInternalServerError, contracts.FormatErrResponse(contracts.ErrInternal))
 			return
 		}
+		models.LocalizeUsers(resUsers.Users, ctx.GetString("language"))
 		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(resUsers))
 	}
 }
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/repositories/external"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxBlobBytes bounds uploads to the local blob storage, which mostly receives certification videos.
+const maxBlobBytes = 200 << 20
+
+type PutBlob struct {
+	blobs  external.LocalBlobStorage
+	logger *zap.Logger
+}
+
+func NewPutBlob(blobs external.LocalBlobStorage, logger *zap.Logger) PutBlob {
+	return PutBlob{blobs: blobs, logger: logger}
+}
+
+// Put Blob godoc
+//
+//	@Summary		Uploads a file to the local blob storage.
+//	@Description	Uploads pictures and videos when the service runs with BLOB_STORAGE=local. Only reachable through signed upload URLs, the body is the raw file.
+//	@Tags			media
+//	@Accept			octet-stream
+//	@Produce		json
+//	@Param			version					path		string	true	"API Version"
+//	@Param			path					path		string	true	"File path"
+//	@Param			expires					query		int		true	"Signature expiration, as a unix timestamp"
+//	@Param			signature				query		string	true	"URL signature"
+//	@Success		200						{object}	string	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400						{object}	contracts.ErrResponse
+//	@Failure		403						{object}	contracts.ErrResponse
+//	@Failure		500						{object}	contracts.ErrResponse
+//	@Router			/{version}/blobs/{path}	[put]
+func (h PutBlob) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		path := ctx.Param("path")
+		err := h.blobs.VerifySignature(http.MethodPut, path, ctx.Query("expires"), ctx.Query("signature"))
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		data, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxBlobBytes+1))
+		if err != nil || len(data) > maxBlobBytes {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		err = h.blobs.Put(ctx, path, data, ctx.ContentType())
+		if err != nil {
+			h.logger.Error("Unable to store blob", zap.Error(err), zap.String("path", path))
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(""))
+	}
+}
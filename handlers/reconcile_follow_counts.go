@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+)
+
+type ReconcileFollowCounts struct {
+	reconciler users.FollowCountReconciler
+}
+
+func NewReconcileFollowCounts(reconciler users.FollowCountReconciler) ReconcileFollowCounts {
+	return ReconcileFollowCounts{reconciler: reconciler}
+}
+
+// Reconcile Follow Counts godoc
+//
+//	@Summary		Recomputes every user's follower and following counts.
+//	@Description	Recomputes the denormalized follow counts from the followers table. The same job runs periodically on its own.
+//	@Tags			followers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version										path		string									true	"API Version"
+//	@Success		200											{object}	users.ReconcileFollowCountsResponse		"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		500											{object}	contracts.ErrResponse
+//	@Router			/{version}/admin/followers/reconcile		[post]
+func (h ReconcileFollowCounts) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		repaired, err := h.reconciler.ReconcileFollowCounts(ctx)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(ucontracts.ReconcileFollowCountsResponse{RepairedUsers: repaired}))
+	}
+}
@@ -32,6 +32,7 @@ func NewUnfollowUser(follows users.UserFollower, logger *zap.Logger) UnfollowUse
 //	@Success		200													{object}	string	"Important Note: OK responses are wrapped in {"data": ... }"
 //	@Failure		400													{object}	contracts.ErrResponse
 //	@Failure		404													{object}	contracts.ErrResponse
+//	@Failure		409													{object}	contracts.ErrResponse
 //	@Failure		500													{object}	contracts.ErrResponse
 //	@Router			/{version}/users/{userID}/followers/{followerID} 	[delete]
 func (h UnfollowUser) Handle() gin.HandlerFunc {
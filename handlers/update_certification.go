@@ -5,7 +5,6 @@ import (
 
 	"github.com/fiufit/users/contracts"
 	certContracts "github.com/fiufit/users/contracts/certifications"
-	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/usecases/certifications"
 	"github.com/gin-gonic/gin"
 )
@@ -34,11 +33,17 @@ func (h UpdateCertification) Handle() gin.HandlerFunc {
 		}
 
 		err = ctx.ShouldBindQuery(&req)
-		_, isCertStatusValid := models.ValidCertificationStatuses[req.Status]
-		if err != nil || !isCertStatusValid {
+		if err == nil && ctx.Request.ContentLength > 0 {
+			err = ctx.ShouldBindJSON(&req)
+		}
+		if err != nil {
 			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
 			return
 		}
+		if err := req.Validate(); err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatValidationErrResponse(err))
+			return
+		}
 		req.CertificationID = cID.CertificationID
 
 		updatedCert, err := h.certUpdater.Update(ctx, req)
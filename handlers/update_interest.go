@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	iContracts "github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/usecases/interests"
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateInterest struct {
+	interests interests.InterestUpdater
+}
+
+func NewUpdateInterest(interests interests.InterestUpdater) UpdateInterest {
+	return UpdateInterest{interests: interests}
+}
+
+type interestName struct {
+	Name string `uri:"interestName" binding:"required"`
+}
+
+// Update Interest godoc
+//
+//	@Summary		Updates an interest of the catalog.
+//	@Description	Updates the display name, icon, translations or active status of an interest. All body params are optional.
+//	@Tags			interests
+//	@Accept			json
+//	@Produce		json
+//	@Param			version										path		string								true	"API Version"
+//	@Param			interestName								path		string								true	"Interest name"
+//	@Param			payload										body		iContracts.UpdateInterestRequest	true	"Body params"
+//	@Success		200											{object}	models.Interest						"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400											{object}	contracts.ErrResponse
+//	@Failure		404											{object}	contracts.ErrResponse
+//	@Failure		500											{object}	contracts.ErrResponse
+//	@Router			/{version}/admin/interests/{interestName}	[patch]
+func (h UpdateInterest) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var name interestName
+		err := ctx.ShouldBindUri(&name)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		var req iContracts.UpdateInterestRequest
+		err = ctx.ShouldBindJSON(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		req.Name = name.Name
+
+		interest, err := h.interests.Update(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(interest))
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	tContracts "github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/usecases/trainers"
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateTrainerProfile struct {
+	profiles trainers.TrainerProfileUpdater
+}
+
+func NewUpdateTrainerProfile(profiles trainers.TrainerProfileUpdater) UpdateTrainerProfile {
+	return UpdateTrainerProfile{profiles: profiles}
+}
+
+// Update Trainer Profile godoc
+//
+//	@Summary		Creates or replaces the profile of a verified trainer.
+//	@Description	Replaces the whole trainer profile. Only available once one of the user's certifications has been approved.
+//	@Tags			trainers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version										path		string									true	"API Version"
+//	@Param			userID										path		string									true	"userID of the trainer"
+//	@Param			payload										body		tContracts.UpdateTrainerProfileRequest	true	"Body params, specialties must be interest names"
+//	@Success		200											{object}	models.TrainerProfile					"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400											{object}	contracts.ErrResponse
+//	@Failure		403											{object}	contracts.ErrResponse
+//	@Failure		404											{object}	contracts.ErrResponse
+//	@Failure		500											{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/trainer-profile	[put]
+func (h UpdateTrainerProfile) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req tContracts.UpdateTrainerProfileRequest
+		err := ctx.ShouldBindJSON(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		req.UserID = ctx.MustGet("userID").(string)
+
+		profile, err := h.profiles.Update(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		profile.Localize(ctx.GetString("language"))
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(profile))
+	}
+}
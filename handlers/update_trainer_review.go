@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	tContracts "github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/usecases/trainers"
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateTrainerReview struct {
+	reviews trainers.TrainerReviewer
+}
+
+func NewUpdateTrainerReview(reviews trainers.TrainerReviewer) UpdateTrainerReview {
+	return UpdateTrainerReview{reviews: reviews}
+}
+
+type reviewerID struct {
+	ReviewerID string `uri:"reviewerID" binding:"required"`
+}
+
+// Update Trainer Review godoc
+//
+//	@Summary		Edits a trainer review.
+//	@Description	Edits the rating or text of the review the reviewer left on the trainer. All body params are optional.
+//	@Tags			trainers
+//	@Accept			json
+//	@Produce		json
+//	@Param			version											path		string							true	"API Version"
+//	@Param			userID											path		string							true	"userID of the trainer"
+//	@Param			reviewerID										path		string							true	"userID of the reviewer"
+//	@Param			payload											body		tContracts.UpdateReviewRequest	true	"Body params"
+//	@Success		200												{object}	models.TrainerReview			"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400												{object}	contracts.ErrResponse
+//	@Failure		404												{object}	contracts.ErrResponse
+//	@Failure		500												{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/reviews/{reviewerID}	[patch]
+func (h UpdateTrainerReview) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var reviewer reviewerID
+		err := ctx.ShouldBindUri(&reviewer)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		var req tContracts.UpdateReviewRequest
+		err = ctx.ShouldBindJSON(&req)
+		validateErr := req.Validate()
+		if err != nil || validateErr != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		req.TrainerID = ctx.MustGet("userID").(string)
+		req.ReviewerID = reviewer.ReviewerID
+
+		review, err := h.reviews.Update(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(review))
+	}
+}
@@ -22,7 +22,7 @@ func NewUpdateUser(users users.UserUpdater, logger *zap.Logger) UpdateUser {
 
 // Update User godoc
 //	@Summary		Updates a user.
-//	@Description	Updates a user profile info.
+//	@Description	Updates a user profile info. On 400, invalid fields are listed in error.fields.
 //	@Tags			accounts
 //	@Accept			json
 //	@Produce		json
@@ -39,11 +39,14 @@ func (h UpdateUser) Handle() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		var req ucontracts.UpdateUserRequest
 		err := ctx.ShouldBindJSON(&req)
-		validateErr := req.Validate()
-		if err != nil || validateErr != nil {
+		if err != nil {
 			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
 			return
 		}
+		if err := req.Validate(); err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatValidationErrResponse(err))
+			return
+		}
 
 		userID := ctx.MustGet("userID").(string)
 		req.ID = userID
@@ -58,10 +61,12 @@ func (h UpdateUser) Handle() gin.HandlerFunc {
 				ctx.JSON(http.StatusConflict, contracts.FormatErrResponse(contracts.ErrUserAlreadyExists))
 				return
 			}
-			ctx.JSON(http.StatusInternalServerError, contracts.FormatErrResponse(contracts.ErrInternal))
+			contracts.HandleErrorType(ctx, err)
 			return
 		}
 
+		updatedUser.LocalizeOwn(ctx.GetString("language"))
+		updatedUser.ConvertUnits()
 		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(updatedUser))
 	}
 }
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/usecases/users"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type UpdateUserPicture struct {
+	users  users.UserPictureUpdater
+	logger *zap.Logger
+}
+
+func NewUpdateUserPicture(users users.UserPictureUpdater, logger *zap.Logger) UpdateUserPicture {
+	return UpdateUserPicture{users: users, logger: logger}
+}
+
+// Update User Picture godoc
+//
+//	@Summary		Uploads the profile picture of a user.
+//	@Description	Uploads a JPEG, PNG or GIF profile picture of up to 5 MB, between 128x128 and 4096x4096 pixels. The picture is stored as PNG along with square thumbnails, and the user is returned with the URL of each size in picture_urls.
+//	@Tags			accounts
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			version								path		string		true	"API Version"
+//	@Param			userID								path		string		true	"User ID"
+//	@Param			picture								formData	file		true	"Profile picture"
+//	@Success		200									{object}	models.User	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400									{object}	contracts.ErrResponse
+//	@Failure		404									{object}	contracts.ErrResponse
+//	@Failure		500									{object}	contracts.ErrResponse
+//	@Router			/{version}/users/{userID}/picture	[put]
+func (h UpdateUserPicture) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// multipart bodies are buffered while parsing, so oversized requests are cut before that
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, ucontracts.MaxPictureRequestBytes)
+		fileHeader, err := ctx.FormFile("picture")
+		if err != nil {
+			if ctx.Request.ContentLength > ucontracts.MaxPictureRequestBytes {
+				validationErr := &contracts.ValidationError{}
+				validationErr.Add("picture", ucontracts.PictureTooBigReason())
+				ctx.JSON(http.StatusBadRequest, contracts.FormatValidationErrResponse(validationErr))
+				return
+			}
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+		defer file.Close()
+
+		// reading one byte over the limit is enough for Validate to reject bigger pictures
+		picture, err := io.ReadAll(io.LimitReader(file, ucontracts.MaxPictureBytes+1))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		req := ucontracts.UpdatePictureRequest{UserID: ctx.MustGet("userID").(string), Picture: picture}
+		if err := req.Validate(); err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatValidationErrResponse(err))
+			return
+		}
+
+		user, err := h.users.UpdatePicture(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		user.LocalizeOwn(ctx.GetString("language"))
+		user.ConvertUnits()
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(user))
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/accounts"
+	"github.com/fiufit/users/usecases/accounts"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type UserLogin struct {
+	users  accounts.Registerer
+	logger *zap.Logger
+}
+
+func NewUserLogin(users accounts.Registerer, logger *zap.Logger) UserLogin {
+	return UserLogin{users: users, logger: logger}
+}
+
+// User Login godoc
+//
+//	@Summary		Log in as user.
+//	@Description	Log in with email and password. Only available when the service is its own identity provider, otherwise clients sign in through Firebase.
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			version						path		string						true	"API Version"
+//	@Param			payload						body		ucontracts.LoginRequest		true	"Body params"
+//	@Success		200							{object}	ucontracts.LoginResponse	"Important Note: OK responses are wrapped in {"data": ... }"
+//	@Failure		400							{object}	contracts.ErrResponse
+//	@Failure		401							{object}	contracts.ErrResponse
+//	@Failure		403							{object}	contracts.ErrResponse
+//	@Failure		500							{object}	contracts.ErrResponse
+//	@Failure		501							{object}	contracts.ErrResponse
+//	@Router			/{version}/users/sign-in 	[post]
+func (h UserLogin) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ucontracts.LoginRequest
+		err := ctx.ShouldBindJSON(&req)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, contracts.FormatErrResponse(contracts.ErrBadRequest))
+			return
+		}
+
+		res, err := h.users.Login(ctx, req)
+		if err != nil {
+			contracts.HandleErrorType(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, contracts.FormatOkResponse(res))
+	}
+}
@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job runs every Interval. Jobs with RunOnStart also run when the scheduler starts, instead of waiting for the first
+// interval.
+type Job struct {
+	Name       string
+	Interval   time.Duration
+	RunOnStart bool
+	Run        func(ctx context.Context) error
+}
+
+// Scheduler runs each of its jobs periodically in its own goroutine, for as long as the service is up.
+type Scheduler struct {
+	jobs   []Job
+	logger *zap.Logger
+}
+
+func NewScheduler(logger *zap.Logger) *Scheduler {
+	return &Scheduler{logger: logger}
+}
+
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.run(ctx, job)
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	if job.RunOnStart {
+		s.runOnce(ctx, job)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runOnce(ctx, job)
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	if err := job.Run(ctx); err != nil {
+		s.logger.Error("Scheduled job failed", zap.String("job", job.Name), zap.Error(err))
+	}
+}
+
+// IntervalFromEnv parses a duration such as "24h" from an env var value, falling back to def when it's empty or
+// invalid.
+func IntervalFromEnv(value string, def time.Duration) time.Duration {
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		return def
+	}
+	return interval
+}
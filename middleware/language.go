@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const languageKey = "language"
+
+// BindLanguage stores the preferred supported language of the Accept-Language header under "language", or an empty
+// string without one. Handlers never fall back to the language of the users they return, unless the response is meant
+// for that user.
+func BindLanguage() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		language := utils.PreferredLanguage(ctx.GetHeader("Accept-Language"), models.ValidLanguages)
+		ctx.Set(languageKey, language)
+	}
+}
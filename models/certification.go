@@ -1,21 +1,124 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 const CertificationStatusPending = "pending"
+const CertificationStatusInReview = "in_review"
 const CertificationStatusDenied = "denied"
 const CertificationStatusApproved = "approved"
+const CertificationStatusRevoked = "revoked"
+const CertificationStatusResubmitted = "resubmitted"
+const CertificationStatusExpired = "expired"
+
+// CertificationRenewalWindow is how long before an approved certification expires its trainer is reminded about it,
+// and can submit a renewal.
+const CertificationRenewalWindow = 30 * 24 * time.Hour
 
 var ValidCertificationStatuses = map[string]struct{}{
-	CertificationStatusPending:  {},
-	CertificationStatusDenied:   {},
-	CertificationStatusApproved: {},
+	CertificationStatusPending:     {},
+	CertificationStatusInReview:    {},
+	CertificationStatusDenied:      {},
+	CertificationStatusApproved:    {},
+	CertificationStatusRevoked:     {},
+	CertificationStatusResubmitted: {},
+	CertificationStatusExpired:     {},
+}
+
+// certificationTransitions is the certification state machine: the statuses each status can move to.
+var certificationTransitions = map[string][]string{
+	CertificationStatusPending:     {CertificationStatusInReview},
+	CertificationStatusResubmitted: {CertificationStatusInReview},
+	CertificationStatusInReview:    {CertificationStatusApproved, CertificationStatusDenied},
+	CertificationStatusApproved:    {CertificationStatusRevoked, CertificationStatusExpired},
+	CertificationStatusDenied:      {CertificationStatusResubmitted},
+}
+
+func CanTransitionCertification(from string, to string) bool {
+	for _, status := range certificationTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpenCertificationStatus tells whether a certification with status is still waiting for a review.
+func IsOpenCertificationStatus(status string) bool {
+	return status == CertificationStatusPending || status == CertificationStatusInReview || status == CertificationStatusResubmitted
+}
+
+const (
+	CertificationActorUser   = "user"
+	CertificationActorAdmin  = "admin"
+	CertificationActorSystem = "system"
+)
+
+// CertificationTransition records a status change of a certification, along with who made it.
+type CertificationTransition struct {
+	ID              uint      `gorm:"primaryKey" json:"-"`
+	CertificationID uint      `gorm:"not null;index" json:"-"`
+	FromStatus      string    `gorm:"not null" json:"from_status"`
+	ToStatus        string    `gorm:"not null" json:"to_status"`
+	ActorType       string    `gorm:"not null" json:"actor_type"`
+	ActorID         string    `json:"actor_id,omitempty"`
+	CreatedAt       time.Time `gorm:"not null" json:"created_at"`
+}
+
+// Reasons a reviewer can deny a certification for, with the explanation sent to the trainer.
+const (
+	CertificationDenialVideoUnclear       = "video_unclear"
+	CertificationDenialIdentityMismatch   = "identity_mismatch"
+	CertificationDenialMissingCredentials = "missing_credentials"
+	CertificationDenialInappropriate      = "inappropriate_content"
+	CertificationDenialOther              = "other"
+)
+
+var CertificationDenialReasons = map[string]string{
+	CertificationDenialVideoUnclear:       "The video isn't clear enough to verify you",
+	CertificationDenialIdentityMismatch:   "The person in the video doesn't match your profile",
+	CertificationDenialMissingCredentials: "The video doesn't show your trainer credentials",
+	CertificationDenialInappropriate:      "The video has inappropriate content",
+	CertificationDenialOther:              "Check the reviewer notes",
+}
+
+// Reasons an administrator can revoke an approved certification for, with the explanation sent to the trainer.
+const (
+	CertificationRevocationFraud              = "fraud"
+	CertificationRevocationExpiredCredentials = "expired_credentials"
+	CertificationRevocationMisconduct         = "misconduct"
+	CertificationRevocationOther              = "other"
+)
+
+var CertificationRevocationReasons = map[string]string{
+	CertificationRevocationFraud:              "Your verification was found to be fraudulent",
+	CertificationRevocationExpiredCredentials: "Your trainer credentials have expired",
+	CertificationRevocationMisconduct:         "Your conduct as a trainer broke our guidelines",
+	CertificationRevocationOther:              "Check the reviewer notes",
 }
 
 type Certification struct {
 	gorm.Model
-	UserID   string
-	User     User
-	Status   string
-	VideoUrl string `gorm:"-"`
+	UserID           string
+	User             User
+	Status           string
+	VideoUrl         string                    `gorm:"-"`
+	RenewalOfID      *uint                     `json:"renewal_of,omitempty"`
+	DenialReason     string                    `json:"denial_reason,omitempty"`
+	RevocationReason string                    `json:"revocation_reason,omitempty"`
+	ReviewerNotes    string                    `json:"reviewer_notes,omitempty"`
+	ReviewerID       *uint                     `json:"reviewer_id,omitempty"`
+	ReviewedAt       *time.Time                `json:"reviewed_at,omitempty"`
+	ExpiresAt        *time.Time                `gorm:"index" json:"expires_at,omitempty"`
+	ExpiryRemindedAt *time.Time                `json:"-"`
+	History          []CertificationTransition `json:"history,omitempty"`
+}
+
+// RenewalOpen tells whether the trainer of an approved certification can already submit its renewal. Certifications
+// without an expiry never need one.
+func (c Certification) RenewalOpen(now time.Time) bool {
+	return c.ExpiresAt != nil && now.After(c.ExpiresAt.Add(-CertificationRenewalWindow))
 }
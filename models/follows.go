@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+const (
+	FollowSourceUnknown    = "unknown"
+	FollowSourceSearch     = "search"
+	FollowSourceSuggestion = "suggestion"
+	FollowSourceProfile    = "profile"
+)
+
+var ValidFollowSources = map[string]bool{
+	FollowSourceUnknown:    true,
+	FollowSourceSearch:     true,
+	FollowSourceSuggestion: true,
+	FollowSourceProfile:    true,
+}
+
+// Follow is a row of the user_followers join table behind User.Followers: FollowerID follows UserID.
+type Follow struct {
+	UserID     string    `gorm:"primaryKey;not null"`
+	FollowerID string    `gorm:"primaryKey;not null"`
+	CreatedAt  time.Time `gorm:"not null;default:CURRENT_TIMESTAMP;index"`
+	Source     string    `gorm:"not null;default:unknown"`
+}
+
+func (Follow) TableName() string {
+	return "user_followers"
+}
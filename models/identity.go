@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Identity holds the credentials of a user when the service is its own identity provider.
+type Identity struct {
+	UserID        string    `gorm:"primaryKey;not null"`
+	Email         string    `gorm:"not null;uniqueIndex"`
+	Password      string    `gorm:"not null" json:"-"`
+	EmailVerified bool      `gorm:"not null;default:false"`
+	Disabled      bool      `gorm:"not null;default:false"`
+	CreatedAt     time.Time `gorm:"not null"`
+}
@@ -1,27 +1,93 @@
 package models
 
-import "github.com/fiufit/users/contracts"
+import (
+	"context"
+	"time"
 
-var validInterests = map[string]struct{}{
-	"strength":    {},
-	"speed":       {},
-	"endurance":   {},
-	"lose weight": {},
-	"gain weight": {},
-	"sports":      {},
+	"github.com/fiufit/users/contracts"
+)
+
+var DefaultInterests = []Interest{
+	{Name: "strength", DisplayName: "Strength", IconKey: "strength", Active: true, Translations: []InterestTranslation{{Language: LanguageSpanish, DisplayName: "Fuerza"}}},
+	{Name: "speed", DisplayName: "Speed", IconKey: "speed", Active: true, Translations: []InterestTranslation{{Language: LanguageSpanish, DisplayName: "Velocidad"}}},
+	{Name: "endurance", DisplayName: "Endurance", IconKey: "endurance", Active: true, Translations: []InterestTranslation{{Language: LanguageSpanish, DisplayName: "Resistencia"}}},
+	{Name: "lose weight", DisplayName: "Lose weight", IconKey: "lose_weight", Active: true, Translations: []InterestTranslation{{Language: LanguageSpanish, DisplayName: "Bajar de peso"}}},
+	{Name: "gain weight", DisplayName: "Gain weight", IconKey: "gain_weight", Active: true, Translations: []InterestTranslation{{Language: LanguageSpanish, DisplayName: "Subir de peso"}}},
+	{Name: "sports", DisplayName: "Sports", IconKey: "sports", Active: true, Translations: []InterestTranslation{{Language: LanguageSpanish, DisplayName: "Deportes"}}},
 }
 
+// Interest.DisplayName holds the DefaultLanguage label, other languages live in Translations.
 type Interest struct {
-	Name string `gorm:"primaryKey;not null;index;unique"`
+	Name         string `gorm:"primaryKey;not null;index;unique"`
+	DisplayName  string
+	IconKey      string
+	Active       bool `gorm:"not null;default:true"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Translations []InterestTranslation `gorm:"foreignKey:InterestName;references:Name"`
+}
+
+type InterestTranslation struct {
+	InterestName string `gorm:"primaryKey;not null"`
+	Language     string `gorm:"primaryKey;not null"`
+	DisplayName  string `gorm:"not null"`
+}
+
+// Localize replaces DisplayName with its translation to language, if there is one.
+func (i *Interest) Localize(language string) {
+	for _, translation := range i.Translations {
+		if translation.Language == language {
+			i.DisplayName = translation.DisplayName
+			return
+		}
+	}
+}
+
+// InterestCatalog is the source of truth for the interests users are allowed to pick.
+type InterestCatalog interface {
+	GetActive(ctx context.Context) ([]Interest, error)
+}
+
+var interestCatalog InterestCatalog
+
+// SetInterestCatalog registers the catalog consulted by ValidateInterests. Until one is set,
+// DefaultInterests are used.
+func SetInterestCatalog(catalog InterestCatalog) {
+	interestCatalog = catalog
 }
 
 func ValidateInterests(interestStrings ...string) ([]Interest, error) {
+	validInterests, err := activeInterests()
+	if err != nil {
+		return []Interest{}, err
+	}
+
 	interests := make([]Interest, len(interestStrings))
 	for i, interest := range interestStrings {
-		if _, exists := validInterests[interest]; !exists {
+		validInterest, exists := validInterests[interest]
+		if !exists {
 			return []Interest{}, contracts.ErrInvalidInterest
 		}
-		interests[i] = Interest{Name: interest}
+		interests[i] = validInterest
+	}
+	return interests, nil
+}
+
+func activeInterests() (map[string]Interest, error) {
+	catalog := DefaultInterests
+	if interestCatalog != nil {
+		var err error
+		catalog, err = interestCatalog.GetActive(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	interests := make(map[string]Interest, len(catalog))
+	for _, interest := range catalog {
+		if interest.Active {
+			interests[interest.Name] = interest
+		}
 	}
 	return interests, nil
 }
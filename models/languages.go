@@ -0,0 +1,15 @@
+package models
+
+const LanguageEnglish = "en"
+const LanguageSpanish = "es"
+const DefaultLanguage = LanguageEnglish
+
+var ValidLanguages = map[string]struct{}{
+	LanguageEnglish: {},
+	LanguageSpanish: {},
+}
+
+func IsValidLanguage(language string) bool {
+	_, ok := ValidLanguages[language]
+	return ok
+}
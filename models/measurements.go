@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// Measurement is a snapshot of a user's body data, taken every time their height or weight changes. Height is
+// in centimeters and weight in kilograms.
+type Measurement struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	UserID     string    `gorm:"not null;index:idx_measurements_user_date" json:"user_id"`
+	Height     float64   `gorm:"not null" json:"height"`
+	Weight     float64   `gorm:"not null" json:"weight"`
+	MeasuredAt time.Time `gorm:"not null;index:idx_measurements_user_date" json:"measured_at"`
+	BMI        float64   `gorm:"-" json:"bmi"`
+}
+
+// BMI returns the body mass index for a weight in kilograms and a height in centimeters, or 0 without a height.
+func BMI(weight float64, height float64) float64 {
+	if height <= 0 {
+		return 0
+	}
+	meters := height / 100
+	return weight / (meters * meters)
+}
+
+func (m *Measurement) FillBMI() {
+	m.BMI = BMI(m.Weight, m.Height)
+}
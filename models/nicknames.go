@@ -0,0 +1,132 @@
+package models
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DefaultReservedNicknames can't be taken by users, as they could be used to impersonate the staff.
+var DefaultReservedNicknames = []string{
+	"admin", "administrator", "fiufit", "moderator", "mod", "support", "staff", "root", "system", "official", "help",
+}
+
+// DefaultProfaneWords are rejected as whole words of a nickname, also in plural.
+var DefaultProfaneWords = []string{
+	"fuck", "shit", "bitch", "cunt", "nigger", "faggot", "nazi", "whore",
+	"puta", "mierda", "pelotudo", "boludo", "forro", "culo", "verga",
+}
+
+// leetReplacer undoes the usual character swaps used to sneak words past the filter.
+var leetReplacer = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "$", "s", "@", "a")
+
+// NicknamePolicy decides which nicknames users can pick and how often. The zero value allows every nickname at any
+// time.
+type NicknamePolicy struct {
+	reserved map[string]struct{}
+	profane  []string
+	// HoldPeriod keeps released nicknames from other users, meanwhile they redirect to their previous owner.
+	HoldPeriod time.Duration
+	// ChangeInterval is the minimum time between two nickname changes of a user.
+	ChangeInterval time.Duration
+}
+
+// NicknameChange records a nickname released by a user.
+type NicknameChange struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    string    `gorm:"not null;index" json:"user_id"`
+	Nickname  string    `gorm:"not null;index:idx_nickname_changes_nickname,expression:LOWER(nickname)" json:"nickname"`
+	ChangedAt time.Time `gorm:"not null;index" json:"changed_at"`
+}
+
+func NewNicknamePolicy(reserved []string, profane []string) NicknamePolicy {
+	policy := NicknamePolicy{reserved: make(map[string]struct{}, len(reserved))}
+	for _, word := range reserved {
+		policy.reserved[normalizeNickname(word)] = struct{}{}
+	}
+	for _, word := range profane {
+		policy.profane = append(policy.profane, normalizeNickname(word))
+	}
+	return policy
+}
+
+// Check describes why nickname is not allowed, or returns an empty string if it is. Reserved words also match
+// when followed by digits, so "admin" covers "Admin_1".
+func (p NicknamePolicy) Check(nickname string) string {
+	normalized := normalizeNickname(nickname)
+	deobfuscated := leetReplacer.Replace(normalized)
+
+	for _, candidate := range []string{normalized, deobfuscated} {
+		if p.isReserved(candidate) || p.isReserved(strings.TrimRight(candidate, "0123456789")) {
+			return "is reserved"
+		}
+	}
+
+	if p.isProfane(nickname) {
+		return "contains inappropriate language"
+	}
+	return ""
+}
+
+// isProfane matches profane words against whole words of nickname, so ordinary words that happen to contain one,
+// like "computadora", are allowed. The whole nickname is matched too, catching spaced out words like "p.u.t.a".
+func (p NicknamePolicy) isProfane(nickname string) bool {
+	for _, word := range append(nicknameWords(nickname), normalizeNickname(nickname)) {
+		trimmed := strings.TrimRight(word, "0123456789")
+		for _, candidate := range []string{word, trimmed, leetReplacer.Replace(word), leetReplacer.Replace(trimmed)} {
+			for _, profane := range p.profane {
+				if candidate == profane || candidate == profane+"s" || candidate == profane+"es" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (p NicknamePolicy) isReserved(nickname string) bool {
+	_, reserved := p.reserved[nickname]
+	return reserved
+}
+
+// nicknameWords splits nickname on dots, underscores and camel case, returning lowercase words.
+func nicknameWords(nickname string) []string {
+	var words []string
+	var word []rune
+	var previous rune
+	for _, r := range nickname {
+		if r == '.' || r == '_' || (unicode.IsUpper(r) && unicode.IsLower(previous)) {
+			if len(word) > 0 {
+				words = append(words, strings.ToLower(string(word)))
+			}
+			word = word[:0]
+		}
+		if r != '.' && r != '_' {
+			word = append(word, r)
+		}
+		previous = r
+	}
+	if len(word) > 0 {
+		words = append(words, strings.ToLower(string(word)))
+	}
+	return words
+}
+
+func normalizeNickname(nickname string) string {
+	nickname = strings.ToLower(nickname)
+	return strings.NewReplacer(".", "", "_", "").Replace(nickname)
+}
+
+// NicknameWordsFromEnv parses a comma separated word list, falling back to def when value is empty.
+func NicknameWordsFromEnv(value string, def []string) []string {
+	var words []string
+	for _, word := range strings.Split(value, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, word)
+		}
+	}
+	if len(words) == 0 {
+		return def
+	}
+	return words
+}
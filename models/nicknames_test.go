@@ -0,0 +1,36 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNicknamePolicyCheck(t *testing.T) {
+	policy := NewNicknamePolicy(DefaultReservedNicknames, DefaultProfaneWords)
+	tests := []struct {
+		nickname string
+		allowed  bool
+	}{
+		{"computadora", true},
+		{"calculo", true},
+		{"ridiculo_92", true},
+		{"disputa", true},
+		{"Vergara", true},
+		{"coach.ana", true},
+		{"Admin", false},
+		{"admin_01", false},
+		{"puta", false},
+		{"putas", false},
+		{"CoachPuta", false},
+		{"coach_culo", false},
+		{"sh1t_lord", false},
+		{"f.u.c.k", false},
+		{"mierda99", false},
+	}
+
+	for _, test := range tests {
+		reason := policy.Check(test.nickname)
+		assert.Equal(t, test.allowed, reason == "", test.nickname)
+	}
+}
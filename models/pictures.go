@@ -0,0 +1,10 @@
+package models
+
+const PictureSizeOriginal = "original"
+
+// PictureThumbnailSizes maps each profile picture thumbnail to its side in pixels. Thumbnails are square.
+var PictureThumbnailSizes = map[string]int{
+	"small":  64,
+	"medium": 128,
+	"large":  256,
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// TrainerProfile is the public profile of a verified trainer. Specialties are drawn from the interest catalog.
+type TrainerProfile struct {
+	UserID            string                 `gorm:"primaryKey;not null" json:"user_id"`
+	Bio               string                 `json:"bio"`
+	YearsOfExperience uint                   `gorm:"not null;default:0" json:"years_of_experience"`
+	Specialties       []Interest             `gorm:"many2many:trainer_specialties" json:"specialties"`
+	Languages         pq.StringArray         `gorm:"type:text[]" json:"languages"`
+	Links             []TrainerLink          `gorm:"foreignKey:UserID;references:UserID" json:"links"`
+	Availability      string                 `json:"availability"`
+	Certifications    []TrainerCertification `gorm:"-" json:"certifications"`
+	AverageRating     float64                `gorm:"-" json:"average_rating"`
+	ReviewCount       int64                  `gorm:"-" json:"review_count"`
+	CreatedAt         time.Time              `json:"created_at"`
+	UpdatedAt         time.Time              `json:"updated_at"`
+}
+
+// TrainerLink is a contact or social link of a trainer, at most one per Kind (e.g. "instagram", "website").
+type TrainerLink struct {
+	UserID string `gorm:"primaryKey;not null" json:"-"`
+	Kind   string `gorm:"primaryKey;not null" json:"kind"`
+	Url    string `gorm:"not null" json:"url"`
+}
+
+// TrainerCertification is the public summary of one of the trainer's approved certifications.
+type TrainerCertification struct {
+	ID         uint      `json:"id"`
+	ApprovedAt time.Time `json:"approved_at"`
+}
+
+func (p *TrainerProfile) Localize(language string) {
+	for i := range p.Specialties {
+		p.Specialties[i].Localize(language)
+		p.Specialties[i].Translations = nil
+	}
+}
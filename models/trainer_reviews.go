@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// TrainerReview is the rating a follower gives a verified trainer. There's at most one per trainer and reviewer.
+type TrainerReview struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	TrainerID  string    `gorm:"not null;uniqueIndex:idx_trainer_reviewer" json:"trainer_id"`
+	ReviewerID string    `gorm:"not null;uniqueIndex:idx_trainer_reviewer" json:"reviewer_id"`
+	Rating     uint      `gorm:"not null" json:"rating"`
+	Review     string    `json:"review"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
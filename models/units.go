@@ -0,0 +1,104 @@
+package models
+
+import (
+	"fmt"
+	"math"
+)
+
+const UnitsMetric = "metric"
+const UnitsImperial = "imperial"
+const DefaultUnits = UnitsMetric
+
+const centimetersPerInch = 2.54
+const kilogramsPerPound = 0.45359237
+
+var ValidUnits = map[string]struct{}{
+	UnitsMetric:   {},
+	UnitsImperial: {},
+}
+
+// BodyDataRange holds the plausible heights and weights accepted for a unit system.
+type BodyDataRange struct {
+	MinHeight float64
+	MaxHeight float64
+	MinWeight float64
+	MaxWeight float64
+}
+
+// BodyDataRanges are in centimeters and kilograms for UnitsMetric, and in inches and pounds for UnitsImperial.
+var BodyDataRanges = map[string]BodyDataRange{
+	UnitsMetric:   {MinHeight: 50, MaxHeight: 275, MinWeight: 20, MaxWeight: 400},
+	UnitsImperial: {MinHeight: 20, MaxHeight: 108, MinWeight: 44, MaxWeight: 880},
+}
+
+func IsValidUnits(units string) bool {
+	_, ok := ValidUnits[units]
+	return ok
+}
+
+// IsValidHeight reports whether height, given in units, is plausible. Zero is never valid.
+func IsValidHeight(height float64, units string) bool {
+	bodyRange, ok := BodyDataRanges[units]
+	return ok && height >= bodyRange.MinHeight && height <= bodyRange.MaxHeight
+}
+
+// IsValidWeight reports whether weight, given in units, is plausible. Zero is never valid.
+func IsValidWeight(weight float64, units string) bool {
+	bodyRange, ok := BodyDataRanges[units]
+	return ok && weight >= bodyRange.MinWeight && weight <= bodyRange.MaxWeight
+}
+
+// CheckHeight describes why height, given in units, is invalid, or returns an empty string if it is valid.
+func CheckHeight(height float64, units string) string {
+	if !IsValidHeight(height, units) {
+		bodyRange := BodyDataRanges[units]
+		return fmt.Sprintf("must be between %v and %v in %s units", bodyRange.MinHeight, bodyRange.MaxHeight, units)
+	}
+	return ""
+}
+
+// CheckWeight describes why weight, given in units, is invalid, or returns an empty string if it is valid.
+func CheckWeight(weight float64, units string) string {
+	if !IsValidWeight(weight, units) {
+		bodyRange := BodyDataRanges[units]
+		return fmt.Sprintf("must be between %v and %v in %s units", bodyRange.MinWeight, bodyRange.MaxWeight, units)
+	}
+	return ""
+}
+
+// HeightToMetric converts a height given in units to centimeters.
+func HeightToMetric(height float64, units string) float64 {
+	if units == UnitsImperial {
+		height *= centimetersPerInch
+	}
+	return roundBodyData(height)
+}
+
+// WeightToMetric converts a weight given in units to kilograms.
+func WeightToMetric(weight float64, units string) float64 {
+	if units == UnitsImperial {
+		weight *= kilogramsPerPound
+	}
+	return roundBodyData(weight)
+}
+
+// HeightFromMetric converts a height in centimeters to units.
+func HeightFromMetric(height float64, units string) float64 {
+	if units == UnitsImperial {
+		height /= centimetersPerInch
+	}
+	return roundBodyData(height)
+}
+
+// WeightFromMetric converts a weight in kilograms to units.
+func WeightFromMetric(weight float64, units string) float64 {
+	if units == UnitsImperial {
+		weight /= kilogramsPerPound
+	}
+	return roundBodyData(weight)
+}
+
+// roundBodyData keeps two decimals, which is more precision than any scale or tape measure gives.
+func roundBodyData(value float64) float64 {
+	return math.Round(value*100) / 100
+}
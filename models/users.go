@@ -8,31 +8,80 @@ import (
 
 type User struct {
 	ID                string    `gorm:"primaryKey;not null"`
-	Nickname          string    `gorm:"not null;unique;index"`
+	Nickname          string    `gorm:"not null;unique;index;uniqueIndex:idx_users_nickname_lower,expression:LOWER(nickname)"`
 	DisplayName       string    `gorm:"not null"`
 	IsMale            bool      `gorm:"not null"`
 	CreatedAt         time.Time `gorm:"not null"`
 	DeletedAt         gorm.DeletedAt
-	BornAt            time.Time  `gorm:"not null"`
-	Height            uint       `gorm:"not null"`
-	Weight            uint       `gorm:"not null"`
-	IsVerifiedTrainer bool       `gorm:"not null;default:false"`
-	Followers         []User     `gorm:"many2many:user_followers"`
-	MainLocation      string     `gorm:"-"`
-	Latitude          float64    `gorm:"not null"`
-	Longitude         float64    `gorm:"not null"`
-	Interests         []Interest `gorm:"many2many:user_interests"`
-	Disabled          bool       `gorm:"not null"`
-	PictureUrl        string     `gorm:"-"`
+	BornAt            time.Time         `gorm:"not null"`
+	Height            float64           `gorm:"not null"`
+	Weight            float64           `gorm:"not null"`
+	Units             string            `gorm:"not null;default:metric" json:"units"`
+	IsVerifiedTrainer bool              `gorm:"not null;default:false"`
+	Followers         []User            `gorm:"many2many:user_followers"`
+	MainLocation      string            `gorm:"not null;default:''"`
+	LocationVersion   int               `gorm:"not null;default:0" json:"-"`
+	Latitude          float64           `gorm:"not null"`
+	Longitude         float64           `gorm:"not null"`
+	Interests         []Interest        `gorm:"many2many:user_interests"`
+	Disabled          bool              `gorm:"not null"`
+	PictureUrl        string            `gorm:"-"`
+	PictureUrls       map[string]string `gorm:"-" json:"picture_urls,omitempty"`
+	PictureUpdatedAt  *time.Time        `json:"-"`
+	HasPicture        *bool             `json:"-"`
+	PictureCheckedAt  *time.Time        `json:"-"`
+	Language          string            `gorm:"not null;default:en"`
+	FollowersCount    int64             `gorm:"not null;default:0" json:"followers_count"`
+	FollowingCount    int64             `gorm:"not null;default:0" json:"following_count"`
+	FollowedAt        *time.Time        `gorm:"->;-:migration" json:"followed_at,omitempty"`
+	TrainerProfile    *TrainerProfile   `gorm:"foreignKey:UserID" json:"trainer_profile,omitempty"`
+	NicknameRedirect  bool              `gorm:"-" json:"nickname_redirect,omitempty"`
+}
+
+// Localize translates the user's interest labels to language, keeping the default labels when none is given.
+// Interest translations are dropped from the user, as only the label is needed.
+func (u *User) Localize(language string) {
+	for i := range u.Interests {
+		u.Interests[i].Localize(language)
+		u.Interests[i].Translations = nil
+	}
+	if u.TrainerProfile != nil {
+		u.TrainerProfile.Localize(language)
+	}
+}
+
+// LocalizeOwn localizes responses meant for the user themselves, falling back to the user's preferred language when
+// none is given. Responses about other users must use Localize, as the viewer's language is unknown.
+func (u *User) LocalizeOwn(language string) {
+	if language == "" {
+		language = u.Language
+	}
+	u.Localize(language)
+}
+
+// ConvertUnits expresses the user's height and weight, stored in centimeters and kilograms, in their preferred units.
+// Only single user profile responses are converted, lists and the model's JSON keep body data metric. Converted
+// users must not be persisted.
+func (u *User) ConvertUnits() {
+	u.Height = HeightFromMetric(u.Height, u.Units)
+	u.Weight = WeightFromMetric(u.Weight, u.Units)
+}
+
+func LocalizeUsers(users []User, language string) {
+	for i := range users {
+		users[i].Localize(language)
+	}
 }
 
 func (u User) ToPublicView() map[string]interface{} {
 	return map[string]interface{}{
-		"id":           u.ID,
-		"nickname":     u.Nickname,
-		"display_name": u.DisplayName,
-		"is_male":      u.IsMale,
-		"is_verified":  u.IsVerifiedTrainer,
+		"id":              u.ID,
+		"nickname":        u.Nickname,
+		"display_name":    u.DisplayName,
+		"is_male":         u.IsMale,
+		"is_verified":     u.IsVerifiedTrainer,
+		"followers_count": u.FollowersCount,
+		"following_count": u.FollowingCount,
 	}
 }
 
@@ -42,6 +91,7 @@ func (u User) ToPrivilegedView() map[string]interface{} {
 	userMap["birth_date"] = u.BornAt
 	userMap["height"] = u.Height
 	userMap["weight"] = u.Weight
+	userMap["language"] = u.Language
 
 	return userMap
 }
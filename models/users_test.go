@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserConvertUnits(t *testing.T) {
+	imperialUser := User{ID: "a", Height: 180, Weight: 80, Units: UnitsImperial}
+	metricUser := User{ID: "b", Height: 180, Weight: 80, Units: UnitsMetric}
+
+	data, err := json.Marshal(imperialUser)
+	assert.NoError(t, err)
+	var marshalled User
+	assert.NoError(t, json.Unmarshal(data, &marshalled))
+	assert.Equal(t, 180.0, marshalled.Height, "the model's JSON stays metric")
+
+	imperialUser.ConvertUnits()
+	metricUser.ConvertUnits()
+
+	assert.Equal(t, 70.87, imperialUser.Height)
+	assert.Equal(t, 176.37, imperialUser.Weight)
+	assert.Equal(t, 180.0, metricUser.Height)
+	assert.Equal(t, 80.0, metricUser.Weight)
+}
+
+func TestUserLocalize(t *testing.T) {
+	newUser := func() User {
+		interest := Interest{Name: "speed", DisplayName: "Speed", Translations: []InterestTranslation{{Language: LanguageSpanish, DisplayName: "Velocidad"}}}
+		return User{ID: "a", Language: LanguageSpanish, Interests: []Interest{interest}}
+	}
+
+	viewed := newUser()
+	viewed.Localize("")
+	assert.Equal(t, "Speed", viewed.Interests[0].DisplayName, "other users keep the default labels")
+	assert.Nil(t, viewed.Interests[0].Translations)
+
+	own := newUser()
+	own.LocalizeOwn("")
+	assert.Equal(t, "Velocidad", own.Interests[0].DisplayName, "own profiles fall back to the user's language")
+
+	requested := newUser()
+	requested.LocalizeOwn(DefaultLanguage)
+	assert.Equal(t, "Speed", requested.Interests[0].DisplayName)
+}
@@ -13,6 +13,7 @@ import (
 //go:generate mockery --name Admins
 type Admins interface {
 	GetByEmail(ctx context.Context, email string) (models.Administrator, error)
+	GetByID(ctx context.Context, id uint) (models.Administrator, error)
 	Create(ctx context.Context, admin models.Administrator) (models.Administrator, error)
 }
 
@@ -51,3 +52,17 @@ func (repo AdminRepository) GetByEmail(ctx context.Context, email string) (model
 	}
 	return admin, nil
 }
+
+func (repo AdminRepository) GetByID(ctx context.Context, id uint) (models.Administrator, error) {
+	db := repo.db.WithContext(ctx)
+
+	var admin models.Administrator
+	result := db.First(&admin, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Administrator{}, contracts.ErrUserNotFound
+		}
+		return models.Administrator{}, result.Error
+	}
+	return admin, nil
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/certifications"
@@ -11,6 +12,7 @@ import (
 	"github.com/fiufit/users/repositories/external"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 //go:generate mockery --name Certifications
@@ -18,7 +20,12 @@ type Certifications interface {
 	Create(ctx context.Context, certification models.Certification) (models.Certification, error)
 	Get(ctx context.Context, request certifications.GetCertificationsRequest) (certifications.GetCertificationsResponse, error)
 	GetByID(ctx context.Context, id uint) (models.Certification, error)
+	GetLatest(ctx context.Context, userID string) (models.Certification, error)
 	Update(ctx context.Context, certification models.Certification) (models.Certification, error)
+	Transition(ctx context.Context, certification models.Certification, transition models.CertificationTransition) (models.Certification, error)
+	GetExpiring(ctx context.Context, before time.Time) ([]models.Certification, error)
+	SetExpiryReminded(ctx context.Context, id uint, remindedAt time.Time) error
+	HasOtherValidApproved(ctx context.Context, userID string, exceptID uint, at time.Time) (bool, error)
 }
 
 type CertificationRepository struct {
@@ -31,12 +38,30 @@ func NewCertificationRepository(db *gorm.DB, logger *zap.Logger, firebase extern
 	return CertificationRepository{db: db, logger: logger, firebase: firebase}
 }
 
+// Create stores a certification submitted by its user, starting its history.
 func (repo CertificationRepository) Create(ctx context.Context, certification models.Certification) (models.Certification, error) {
 	db := repo.db.WithContext(ctx)
-	result := db.Create(&certification)
-	if result.Error != nil {
-		repo.logger.Error("Unable to create certification", zap.Any("cert", certification), zap.Error(result.Error))
-		return models.Certification{}, result.Error
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(&certification).Error; err != nil {
+			return err
+		}
+
+		transition := models.CertificationTransition{
+			CertificationID: certification.ID,
+			ToStatus:        certification.Status,
+			ActorType:       models.CertificationActorUser,
+			ActorID:         certification.UserID,
+			CreatedAt:       certification.CreatedAt,
+		}
+		if err := tx.Create(&transition).Error; err != nil {
+			return err
+		}
+		certification.History = []models.CertificationTransition{transition}
+		return nil
+	})
+	if err != nil {
+		repo.logger.Error("Unable to create certification", zap.Any("cert", certification), zap.Error(err))
+		return models.Certification{}, err
 	}
 
 	repo.fillCertificationVideoUrl(ctx, &certification)
@@ -47,7 +72,7 @@ func (repo CertificationRepository) GetByID(ctx context.Context, id uint) (model
 	db := repo.db.WithContext(ctx)
 	var cert models.Certification
 
-	res := db.Where("id = ?", id).First(&cert)
+	res := db.Where("id = ?", id).Scopes(preloadHistory).First(&cert)
 	if res.Error != nil {
 		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 			return models.Certification{}, contracts.ErrCertificationNotFound
@@ -73,7 +98,7 @@ func (repo CertificationRepository) Get(ctx context.Context, request certificati
 		db = db.Where("user_id = ?", request.UserID)
 	}
 
-	result := db.Order("created_at desc").Scopes(database.Paginate(res, &request.Pagination, db)).Find(&res)
+	result := db.Order("created_at desc").Scopes(database.Paginate(res, &request.Pagination, db), preloadHistory).Find(&res)
 	if result.Error != nil {
 		repo.logger.Error("Unable to get certifications", zap.Any("req", request), zap.Error(result.Error))
 		return certifications.GetCertificationsResponse{}, result.Error
@@ -102,9 +127,114 @@ func (repo CertificationRepository) Update(ctx context.Context, certification mo
 	return certification, nil
 }
 
+// GetLatest returns the last certification submitted by a user.
+func (repo CertificationRepository) GetLatest(ctx context.Context, userID string) (models.Certification, error) {
+	db := repo.db.WithContext(ctx)
+	var cert models.Certification
+
+	res := db.Where("user_id = ?", userID).Order("created_at desc").Order("id desc").Scopes(preloadHistory).First(&cert)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			return models.Certification{}, contracts.ErrCertificationNotFound
+		}
+
+		repo.logger.Error("Unable to get latest certification", zap.Error(res.Error), zap.String("userID", userID))
+		return models.Certification{}, res.Error
+	}
+
+	repo.fillCertificationVideoUrl(ctx, &cert)
+	return cert, nil
+}
+
+// Transition moves a certification from transition.FromStatus to transition.ToStatus, saving its review fields and
+// recording the transition. It fails with contracts.ErrInvalidCertTransition if the certification status
+// changed in the meantime.
+func (repo CertificationRepository) Transition(ctx context.Context, certification models.Certification, transition models.CertificationTransition) (models.Certification, error) {
+	db := repo.db.WithContext(ctx)
+	transition.CertificationID = certification.ID
+	certification.Status = transition.ToStatus
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&certification).Where("status = ?", transition.FromStatus).
+			Select("status", "denial_reason", "revocation_reason", "reviewer_notes", "reviewer_id", "reviewed_at", "expires_at", "updated_at").
+			Updates(&certification)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return contracts.ErrInvalidCertTransition
+		}
+
+		if err := tx.Create(&transition).Error; err != nil {
+			return err
+		}
+		return tx.Where("certification_id = ?", certification.ID).Order("created_at").Order("id").Find(&certification.History).Error
+	})
+	if err != nil {
+		if !errors.Is(err, contracts.ErrInvalidCertTransition) {
+			repo.logger.Error("Unable to transition certification", zap.Any("cert", certification), zap.Any("transition", transition), zap.Error(err))
+		}
+		return models.Certification{}, err
+	}
+
+	repo.fillCertificationVideoUrl(ctx, &certification)
+	return certification, nil
+}
+
+func preloadHistory(db *gorm.DB) *gorm.DB {
+	return db.Preload("History", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at").Order("id")
+	})
+}
+
 func (repo CertificationRepository) fillCertificationVideoUrl(ctx context.Context, cert *models.Certification) {
 	if cert == nil {
 		return
 	}
 	cert.VideoUrl = repo.firebase.GetCertificationVideoUrl(ctx, cert.UserID)
 }
+
+// GetExpiring returns the approved certifications that expire before the given time, including the already expired
+// ones, soonest first.
+func (repo CertificationRepository) GetExpiring(ctx context.Context, before time.Time) ([]models.Certification, error) {
+	db := repo.db.WithContext(ctx)
+	var certs []models.Certification
+
+	res := db.Where("status = ? AND expires_at < ?", models.CertificationStatusApproved, before).Order("expires_at").Find(&certs)
+	if res.Error != nil {
+		repo.logger.Error("Unable to get expiring certifications", zap.Error(res.Error), zap.Time("before", before))
+		return nil, res.Error
+	}
+	return certs, nil
+}
+
+// HasOtherValidApproved reports whether userID has an approved certification other than exceptID that hasn't expired
+// at the given time.
+func (repo CertificationRepository) HasOtherValidApproved(ctx context.Context, userID string, exceptID uint, at time.Time) (bool, error) {
+	db := repo.db.WithContext(ctx)
+	var count int64
+
+	res := db.Model(&models.Certification{}).
+		Where("user_id = ? AND id <> ? AND status = ?", userID, exceptID, models.CertificationStatusApproved).
+		Where("expires_at IS NULL OR expires_at > ?", at).
+		Count(&count)
+	if res.Error != nil {
+		repo.logger.Error("Unable to get valid certifications", zap.Error(res.Error), zap.String("userID", userID))
+		return false, res.Error
+	}
+	return count > 0, nil
+}
+
+func (repo CertificationRepository) SetExpiryReminded(ctx context.Context, id uint, remindedAt time.Time) error {
+	db := repo.db.WithContext(ctx)
+
+	res := db.Model(&models.Certification{}).Where("id = ?", id).UpdateColumn("expiry_reminded_at", remindedAt)
+	if res.Error != nil {
+		repo.logger.Error("Unable to set certification expiry reminder", zap.Error(res.Error), zap.Uint("id", id))
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return contracts.ErrCertificationNotFound
+	}
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/certifications"
 	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/repositories/mocks"
@@ -225,3 +227,148 @@ func TestCertificationRepository_Update_Ok(t *testing.T) {
 	assert.Equal(t, updatedCert.UserID, dbCert.UserID)
 	assert.Equal(t, updatedCert.Status, dbCert.Status)
 }
+
+func TestCertificationRepository_Transition_RecordsHistory(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB.WithContext(ctx)
+
+	logger := zaptest.NewLogger(t)
+	firebase := new(mocks.Firebase)
+	firebase.On("GetCertificationVideoUrl", ctx, mock.Anything).Return("testurl")
+
+	repo := NewCertificationRepository(db, logger, firebase)
+
+	testUser := models.User{ID: "testuser"}
+	db.Create(&testUser)
+
+	cert, err := repo.Create(ctx, models.Certification{UserID: testUser.ID, Status: models.CertificationStatusPending})
+	assert.NoError(t, err)
+
+	cert, err = repo.Transition(ctx, cert, models.CertificationTransition{
+		FromStatus: models.CertificationStatusPending,
+		ToStatus:   models.CertificationStatusInReview,
+		ActorType:  models.CertificationActorAdmin,
+		ActorID:    "1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.CertificationStatusInReview, cert.Status)
+
+	latest, err := repo.GetLatest(ctx, testUser.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, cert.ID, latest.ID)
+	assert.Equal(t, models.CertificationStatusInReview, latest.Status)
+	assert.Len(t, latest.History, 2)
+	assert.Equal(t, models.CertificationStatusPending, latest.History[0].ToStatus)
+	assert.Equal(t, models.CertificationActorUser, latest.History[0].ActorType)
+	assert.Equal(t, models.CertificationStatusInReview, latest.History[1].ToStatus)
+	assert.Equal(t, "1", latest.History[1].ActorID)
+}
+
+func TestCertificationRepository_Transition_StaleStatus(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB.WithContext(ctx)
+
+	logger := zaptest.NewLogger(t)
+	firebase := new(mocks.Firebase)
+	firebase.On("GetCertificationVideoUrl", ctx, mock.Anything).Return("testurl")
+
+	repo := NewCertificationRepository(db, logger, firebase)
+
+	testUser := models.User{ID: "testuser"}
+	db.Create(&testUser)
+
+	cert, err := repo.Create(ctx, models.Certification{UserID: testUser.ID, Status: models.CertificationStatusPending})
+	assert.NoError(t, err)
+
+	_, err = repo.Transition(ctx, cert, models.CertificationTransition{
+		FromStatus: models.CertificationStatusInReview,
+		ToStatus:   models.CertificationStatusApproved,
+		ActorType:  models.CertificationActorAdmin,
+	})
+	assert.ErrorIs(t, err, contracts.ErrInvalidCertTransition)
+
+	dbCert, err := repo.GetByID(ctx, cert.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.CertificationStatusPending, dbCert.Status)
+	assert.Len(t, dbCert.History, 1)
+}
+
+func TestCertificationRepository_GetLatest_NotFound(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB.WithContext(ctx)
+
+	repo := NewCertificationRepository(db, zaptest.NewLogger(t), new(mocks.Firebase))
+
+	_, err := repo.GetLatest(ctx, "nobody")
+	assert.ErrorIs(t, err, contracts.ErrCertificationNotFound)
+}
+
+func TestCertificationRepository_GetExpiring(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB.WithContext(ctx)
+
+	logger := zaptest.NewLogger(t)
+	firebase := new(mocks.Firebase)
+	firebase.On("GetCertificationVideoUrl", ctx, mock.Anything).Return("testurl")
+
+	repo := NewCertificationRepository(db, logger, firebase)
+
+	db.Create(&models.User{ID: "a"})
+	db.Create(&models.User{ID: "b"})
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(365 * 24 * time.Hour)
+	expiring, err := repo.Create(ctx, models.Certification{UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &soon})
+	assert.NoError(t, err)
+	_, err = repo.Create(ctx, models.Certification{UserID: "b", Status: models.CertificationStatusApproved, ExpiresAt: &later})
+	assert.NoError(t, err)
+	_, err = repo.Create(ctx, models.Certification{UserID: "b", Status: models.CertificationStatusPending})
+	assert.NoError(t, err)
+
+	certs, err := repo.GetExpiring(ctx, time.Now().Add(models.CertificationRenewalWindow))
+	assert.NoError(t, err)
+	assert.Len(t, certs, 1)
+	assert.Equal(t, expiring.ID, certs[0].ID)
+	assert.Nil(t, certs[0].ExpiryRemindedAt)
+
+	assert.NoError(t, repo.SetExpiryReminded(ctx, expiring.ID, time.Now()))
+	certs, err = repo.GetExpiring(ctx, time.Now().Add(models.CertificationRenewalWindow))
+	assert.NoError(t, err)
+	assert.NotNil(t, certs[0].ExpiryRemindedAt)
+
+	assert.ErrorIs(t, repo.SetExpiryReminded(ctx, 999, time.Now()), contracts.ErrCertificationNotFound)
+}
+
+func TestCertificationRepository_HasOtherValidApproved(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB.WithContext(ctx)
+
+	firebase := new(mocks.Firebase)
+	firebase.On("GetCertificationVideoUrl", ctx, mock.Anything).Return("testurl")
+	repo := NewCertificationRepository(db, zaptest.NewLogger(t), firebase)
+
+	db.Create(&models.User{ID: "a"})
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(365 * 24 * time.Hour)
+	original, err := repo.Create(ctx, models.Certification{UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &soon})
+	assert.NoError(t, err)
+	renewal, err := repo.Create(ctx, models.Certification{UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &later, RenewalOfID: &original.ID})
+	assert.NoError(t, err)
+
+	// revoking the renewal leaves the original valid
+	valid, err := repo.HasOtherValidApproved(ctx, "a", renewal.ID, time.Now())
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = repo.HasOtherValidApproved(ctx, "a", renewal.ID, soon.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, err = repo.HasOtherValidApproved(ctx, "a", original.ID, time.Now())
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
@@ -3,197 +3,135 @@ package external
 import (
 	"context"
 	"errors"
-	"strings"
+	"strconv"
 	"time"
 
-	"cloud.google.com/go/storage"
-	firebase "firebase.google.com/go/v4"
-	"firebase.google.com/go/v4/auth"
 	"github.com/fiufit/users/contracts"
-	"github.com/fiufit/users/contracts/accounts"
+	"github.com/fiufit/users/models"
 	"go.uber.org/zap"
-	"google.golang.org/api/option"
 )
 
 //go:generate mockery --name Firebase
 type Firebase interface {
-	Register(ctx context.Context, req accounts.RegisterRequest) (string, error)
-	DeleteUser(ctx context.Context, userID string) error
-	GetUserPictureUrl(ctx context.Context, userID string) string
+	HasLegacyPicture(ctx context.Context, userID string) (bool, error)
+	GetUserPictureUrl(ctx context.Context, userID string, hasPicture bool) string
+	GetUserPictureUrls(ctx context.Context, userID string, updatedAt time.Time) map[string]string
+	UploadUserPicture(ctx context.Context, userID string, pictures map[string][]byte) error
 	GetCertificationVideoUrl(ctx context.Context, userID string) string
-	EnableUser(ctx context.Context, userID string) error
-	DisableUser(ctx context.Context, userID string) error
-	UserIsVerified(ctx context.Context, userID string) (bool, error)
-	VerifyUser(ctx context.Context, userID string) error
 }
 
 type FirebaseRepository struct {
-	logger            *zap.Logger
-	app               *firebase.App
-	auth              *auth.Client
-	storageBucketName string
-	storageBucket     *storage.BucketHandle
+	logger     *zap.Logger
+	blobs      BlobStorage
+	signedUrls *signedUrlCache
 }
 
-func NewFirebaseRepository(logger *zap.Logger, sdkJson []byte, storageBucketName string) (FirebaseRepository, error) {
-	opt := option.WithCredentialsJSON(sdkJson)
-	app, err := firebase.NewApp(context.Background(), nil, opt)
-	if err != nil {
-		return FirebaseRepository{}, err
-	}
-
-	client, err := app.Auth(context.Background())
-	if err != nil {
-		return FirebaseRepository{}, err
-	}
-
-	storageClient, err := app.Storage(context.Background())
-	if err != nil {
-		return FirebaseRepository{}, err
-	}
+// NewFirebaseRepository serves user media stored in blobs. Authentication is handled by an IdentityProvider.
+func NewFirebaseRepository(logger *zap.Logger, blobs BlobStorage) FirebaseRepository {
+	return FirebaseRepository{logger: logger, blobs: blobs, signedUrls: newSignedUrlCache()}
+}
 
-	storageBucket, err := storageClient.Bucket(storageBucketName)
+// HasLegacyPicture looks up a picture uploaded by clients straight to storage instead of through the service.
+func (repo FirebaseRepository) HasLegacyPicture(ctx context.Context, userID string) (bool, error) {
+	_, err := repo.blobs.Stat(ctx, userPicturePath(userID, models.PictureSizeOriginal))
 	if err != nil {
-		return FirebaseRepository{}, err
-	}
-
-	repo := FirebaseRepository{
-		logger:            logger,
-		app:               app,
-		auth:              client,
-		storageBucketName: storageBucketName,
-		storageBucket:     storageBucket,
+		if errors.Is(err, contracts.ErrBlobNotFound) {
+			return false, nil
+		}
+		repo.logger.Error("Unable to retrieve User picture from storage", zap.Error(err), zap.String("userID", userID))
+		return false, err
 	}
-
-	return repo, nil
-}
-
-func (repo FirebaseRepository) DeleteUser(ctx context.Context, userID string) error {
-	return repo.auth.DeleteUser(ctx, userID)
+	return true, nil
 }
 
-func (repo FirebaseRepository) DisableUser(ctx context.Context, userID string) error {
-	usr, err := repo.auth.GetUser(ctx, userID)
-	if err != nil {
-		return err
-	}
-	if usr.Disabled {
-		return contracts.ErrUserAlreadyDisabled
+// GetUserPictureUrl signs the original picture of users that have one, or the default picture otherwise.
+func (repo FirebaseRepository) GetUserPictureUrl(ctx context.Context, userID string, hasPicture bool) string {
+	picturePath := "profile_pictures/default.png"
+	if hasPicture {
+		picturePath = userPicturePath(userID, models.PictureSizeOriginal)
 	}
-	updateUserParams := (&auth.UserToUpdate{}).Disabled(true)
-	_, err = repo.auth.UpdateUser(ctx, userID, updateUserParams)
-	return err
-}
 
-func (repo FirebaseRepository) EnableUser(ctx context.Context, userID string) error {
-	usr, err := repo.auth.GetUser(ctx, userID)
+	pictureUrl, err := repo.signPicture(picturePath, "")
 	if err != nil {
-		return err
+		repo.logger.Error("Unable to Sign user picture from storage", zap.String("userID", userID))
 	}
-	if !usr.Disabled {
-		return contracts.ErrUserNotDisabled
-	}
-	updateUserParams := (&auth.UserToUpdate{}).Disabled(false)
-	_, err = repo.auth.UpdateUser(ctx, userID, updateUserParams)
-	return err
+	return pictureUrl
 }
 
-func (repo FirebaseRepository) Register(ctx context.Context, req accounts.RegisterRequest) (string, error) {
-	email := strings.ToLower(req.Email)
-	pw := req.Password
-	user, err := repo.auth.GetUserByEmail(ctx, email)
-	if err == nil && user != nil {
-		if user.EmailVerified {
-			return "", contracts.ErrUserAlreadyExists
-		}
-
-		updateUserParams := (&auth.UserToUpdate{}).Password(pw)
-		updatedUser, err := repo.auth.UpdateUser(ctx, user.UID, updateUserParams)
+// GetUserPictureUrls signs the URLs of every size of a picture uploaded with UploadUserPicture at updatedAt. Blobs
+// aren't looked up, so it must only be called for users that uploaded one. Signed URLs are cached per upload, so a new
+// picture gets new URLs on every instance and isn't hidden by browser caches.
+func (repo FirebaseRepository) GetUserPictureUrls(ctx context.Context, userID string, updatedAt time.Time) map[string]string {
+	pictureUrls := make(map[string]string, len(models.PictureThumbnailSizes)+1)
+	version := strconv.FormatInt(updatedAt.UnixNano(), 10)
+	for _, size := range pictureSizes() {
+		pictureUrl, err := repo.signPicture(userPicturePath(userID, size), version)
 		if err != nil {
-			return "", err
+			repo.logger.Error("Unable to Sign user picture from storage", zap.String("userID", userID), zap.String("size", size))
+			continue
 		}
-		return updatedUser.UID, nil
+		pictureUrls[size] = pictureUrl
 	}
+	return pictureUrls
+}
 
-	params := (&auth.UserToCreate{}).Email(email).Password(pw).EmailVerified(false)
-	newUser, err := repo.auth.CreateUser(ctx, params)
+// signPicture caches signed URLs by path and version, so uploads overwriting the same path can be told apart.
+func (repo FirebaseRepository) signPicture(path string, version string) (string, error) {
+	key := path + "@" + version
+	if pictureUrl, ok := repo.signedUrls.get(key); ok {
+		return pictureUrl, nil
+	}
+
+	pictureUrl, err := repo.blobs.SignedGetUrl(path, pictureUrlExpiry)
 	if err != nil {
 		return "", err
 	}
-	return newUser.UID, nil
+	repo.signedUrls.set(key, pictureUrl)
+	return pictureUrl, nil
 }
 
-func (repo FirebaseRepository) GetUserPictureUrl(ctx context.Context, userID string) string {
-	defaultPicturePath := "profile_pictures/default.png"
-	userPicturePath := "profile_pictures/" + userID + "/profile.png"
-	picturePath := userPicturePath
-
-	pictureHandle := repo.storageBucket.Object(userPicturePath)
-	_, err := pictureHandle.Attrs(ctx)
-	if err != nil {
-		if !errors.Is(err, storage.ErrObjectNotExist) {
-			repo.logger.Error("Unable to retrieve User picture from firebase storage", zap.String("userID", userID))
+// UploadUserPicture stores PNG pictures keyed by size, as returned by utils.ProcessPicture.
+func (repo FirebaseRepository) UploadUserPicture(ctx context.Context, userID string, pictures map[string][]byte) error {
+	for size, picture := range pictures {
+		if err := repo.blobs.Put(ctx, userPicturePath(userID, size), picture, "image/png"); err != nil {
+			repo.logger.Error("Unable to upload user picture to storage", zap.Error(err), zap.String("userID", userID), zap.String("size", size))
+			return err
 		}
-		picturePath = defaultPicturePath
 	}
+	return nil
+}
 
-	opts := storage.SignedURLOptions{
-		Method:  "GET",
-		Expires: time.Now().Add(time.Hour * 24 * 7),
+// userPicturePath keeps the original picture where clients used to upload it, so older pictures are still found.
+func userPicturePath(userID string, size string) string {
+	if size == models.PictureSizeOriginal {
+		return "profile_pictures/" + userID + "/profile.png"
 	}
-	pictureUrl, err := repo.storageBucket.SignedURL(picturePath, &opts)
-	if err != nil {
-		pictureUrl = ""
-		repo.logger.Error("Unable to Sign user picture from firebase storage", zap.String("userID", userID))
+	return "profile_pictures/" + userID + "/profile_" + size + ".png"
+}
+
+func pictureSizes() []string {
+	sizes := []string{models.PictureSizeOriginal}
+	for size := range models.PictureThumbnailSizes {
+		sizes = append(sizes, size)
 	}
-	return pictureUrl
+	return sizes
 }
 
 func (repo FirebaseRepository) GetCertificationVideoUrl(ctx context.Context, userID string) string {
 	userVideoPath := "verification_videos/" + userID + "/video.mp4"
 
-	pictureHandle := repo.storageBucket.Object(userVideoPath)
-	_, err := pictureHandle.Attrs(ctx)
+	_, err := repo.blobs.Stat(ctx, userVideoPath)
 	if err != nil {
-		if !errors.Is(err, storage.ErrObjectNotExist) {
-			repo.logger.Error("Unable to retrieve certification video from firebase storage", zap.String("userID", userID))
+		if !errors.Is(err, contracts.ErrBlobNotFound) {
+			repo.logger.Error("Unable to retrieve certification video from storage", zap.String("userID", userID))
 		}
 		return ""
 	}
 
-	opts := storage.SignedURLOptions{
-		Method:  "GET",
-		Expires: time.Now().Add(time.Hour * 24),
-	}
-	pictureUrl, err := repo.storageBucket.SignedURL(userVideoPath, &opts)
+	videoUrl, err := repo.blobs.SignedGetUrl(userVideoPath, time.Hour*24)
 	if err != nil {
-		pictureUrl = ""
-		repo.logger.Error("Unable to Sign certification video from firebase storage", zap.String("userID", userID))
-	}
-	return pictureUrl
-}
-
-func (repo FirebaseRepository) UserIsVerified(ctx context.Context, userID string) (bool, error) {
-	user, err := repo.auth.GetUser(ctx, userID)
-	if err != nil {
-		return false, contracts.ErrUserNotFound
-	}
-	return user.EmailVerified, nil
-}
-
-func (repo FirebaseRepository) VerifyUser(ctx context.Context, userID string) error {
-	user, err := repo.auth.GetUser(ctx, userID)
-	if err == nil && user != nil {
-		if user.EmailVerified {
-			return contracts.ErrUserAlreadyVerified
-		}
-
-		updateUserParams := (&auth.UserToUpdate{}).EmailVerified(true)
-		_, err := repo.auth.UpdateUser(ctx, user.UID, updateUserParams)
-		if err != nil {
-			return err
-		}
-		return nil
+		videoUrl = ""
+		repo.logger.Error("Unable to Sign certification video from storage", zap.String("userID", userID))
 	}
-	return contracts.ErrUserNotFound
+	return videoUrl
 }
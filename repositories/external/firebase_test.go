@@ -0,0 +1,78 @@
+package external
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fiufit/users/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestFirebaseRepository_HasLegacyPicture(t *testing.T) {
+	ctx := context.Background()
+	blobs, err := NewLocalBlobStorage(t.TempDir(), "http://localhost/v1/blobs", []byte("secret"))
+	assert.NoError(t, err)
+	repo := NewFirebaseRepository(zaptest.NewLogger(t), blobs)
+
+	hasPicture, err := repo.HasLegacyPicture(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, hasPicture)
+
+	err = blobs.Put(ctx, userPicturePath("a", models.PictureSizeOriginal), []byte("picture"), "image/png")
+	assert.NoError(t, err)
+
+	hasPicture, err = repo.HasLegacyPicture(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, hasPicture)
+}
+
+func TestFirebaseRepository_GetUserPictureUrlIsCached(t *testing.T) {
+	ctx := context.Background()
+	blobs, err := NewLocalBlobStorage(t.TempDir(), "http://localhost/v1/blobs", []byte("secret"))
+	assert.NoError(t, err)
+	repo := NewFirebaseRepository(zaptest.NewLogger(t), blobs)
+
+	pictureUrl := repo.GetUserPictureUrl(ctx, "a", true)
+	assert.True(t, strings.HasPrefix(pictureUrl, "http://localhost/v1/blobs/profile_pictures/a/profile.png"))
+
+	time.Sleep(time.Second)
+	assert.Equal(t, pictureUrl, repo.GetUserPictureUrl(ctx, "a", true))
+
+	defaultUrl := repo.GetUserPictureUrl(ctx, "a", false)
+	assert.True(t, strings.HasPrefix(defaultUrl, "http://localhost/v1/blobs/profile_pictures/default.png"))
+}
+
+func TestFirebaseRepository_GetUserPictureUrlsChangeOnUpload(t *testing.T) {
+	ctx := context.Background()
+	blobs, err := NewLocalBlobStorage(t.TempDir(), "http://localhost/v1/blobs", []byte("secret"))
+	assert.NoError(t, err)
+	repo := NewFirebaseRepository(zaptest.NewLogger(t), blobs)
+	uploadedAt := time.Now()
+
+	pictureUrls := repo.GetUserPictureUrls(ctx, "a", uploadedAt)
+	assert.Len(t, pictureUrls, len(models.PictureThumbnailSizes)+1)
+
+	time.Sleep(time.Second)
+	assert.Equal(t, pictureUrls, repo.GetUserPictureUrls(ctx, "a", uploadedAt))
+
+	reuploadedUrls := repo.GetUserPictureUrls(ctx, "a", uploadedAt.Add(time.Second))
+	for size, pictureUrl := range pictureUrls {
+		assert.NotEqual(t, pictureUrl, reuploadedUrls[size], size)
+	}
+}
+
+func TestSignedUrlCache_Expiry(t *testing.T) {
+	cache := newSignedUrlCache()
+	cache.set("a", "url")
+
+	cachedUrl, ok := cache.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "url", cachedUrl)
+
+	cache.entries["a"] = signedUrl{url: "url", expiresAt: time.Now().Add(-time.Minute)}
+	_, ok = cache.get("a")
+	assert.False(t, ok)
+}
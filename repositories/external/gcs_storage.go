@@ -0,0 +1,69 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/fiufit/users/contracts"
+	"google.golang.org/api/option"
+)
+
+// GCSBlobStorage keeps blobs in a Google Cloud Storage bucket, such as the Firebase project's one.
+type GCSBlobStorage struct {
+	bucket *storage.BucketHandle
+}
+
+func NewGCSBlobStorage(ctx context.Context, sdkJson []byte, bucketName string) (GCSBlobStorage, error) {
+	client, err := storage.NewClient(ctx, option.WithCredentialsJSON(sdkJson))
+	if err != nil {
+		return GCSBlobStorage{}, err
+	}
+	return GCSBlobStorage{bucket: client.Bucket(bucketName)}, nil
+}
+
+func (s GCSBlobStorage) Put(ctx context.Context, path string, data []byte, contentType string) error {
+	writer := s.bucket.Object(path).NewWriter(ctx)
+	writer.ContentType = contentType
+	if _, err := writer.Write(data); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (s GCSBlobStorage) Stat(ctx context.Context, path string) (BlobAttrs, error) {
+	attrs, err := s.bucket.Object(path).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return BlobAttrs{}, contracts.ErrBlobNotFound
+		}
+		return BlobAttrs{}, err
+	}
+	return BlobAttrs{Size: attrs.Size, UpdatedAt: attrs.Updated}, nil
+}
+
+func (s GCSBlobStorage) Delete(ctx context.Context, path string) error {
+	err := s.bucket.Object(path).Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return contracts.ErrBlobNotFound
+	}
+	return err
+}
+
+func (s GCSBlobStorage) SignedGetUrl(path string, expiresIn time.Duration) (string, error) {
+	return s.bucket.SignedURL(path, &storage.SignedURLOptions{
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(expiresIn),
+	})
+}
+
+func (s GCSBlobStorage) SignedPutUrl(path string, contentType string, expiresIn time.Duration) (string, error) {
+	return s.bucket.SignedURL(path, &storage.SignedURLOptions{
+		Method:      http.MethodPut,
+		ContentType: contentType,
+		Expires:     time.Now().Add(expiresIn),
+	})
+}
@@ -0,0 +1,132 @@
+package external
+
+import (
+	"context"
+	"strings"
+
+	firebase "firebase.google.com/go/v4"
+	"firebase.google.com/go/v4/auth"
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/accounts"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+)
+
+// IdentityProvider owns user credentials: it registers them, issues tokens, and tracks whether accounts are
+// verified or disabled.
+//
+//go:generate mockery --name IdentityProvider
+type IdentityProvider interface {
+	Register(ctx context.Context, req accounts.RegisterRequest) (string, error)
+	Login(ctx context.Context, req accounts.LoginRequest) (accounts.LoginResponse, error)
+	DeleteUser(ctx context.Context, userID string) error
+	EnableUser(ctx context.Context, userID string) error
+	DisableUser(ctx context.Context, userID string) error
+	UserIsVerified(ctx context.Context, userID string) (bool, error)
+	VerifyUser(ctx context.Context, userID string) error
+}
+
+type FirebaseIdentityProvider struct {
+	logger *zap.Logger
+	auth   *auth.Client
+}
+
+func NewFirebaseIdentityProvider(logger *zap.Logger, sdkJson []byte) (FirebaseIdentityProvider, error) {
+	opt := option.WithCredentialsJSON(sdkJson)
+	app, err := firebase.NewApp(context.Background(), nil, opt)
+	if err != nil {
+		return FirebaseIdentityProvider{}, err
+	}
+
+	client, err := app.Auth(context.Background())
+	if err != nil {
+		return FirebaseIdentityProvider{}, err
+	}
+
+	return FirebaseIdentityProvider{logger: logger, auth: client}, nil
+}
+
+func (repo FirebaseIdentityProvider) Register(ctx context.Context, req accounts.RegisterRequest) (string, error) {
+	email := strings.ToLower(req.Email)
+	pw := req.Password
+	user, err := repo.auth.GetUserByEmail(ctx, email)
+	if err == nil && user != nil {
+		if user.EmailVerified {
+			return "", contracts.ErrUserAlreadyExists
+		}
+
+		updateUserParams := (&auth.UserToUpdate{}).Password(pw)
+		updatedUser, err := repo.auth.UpdateUser(ctx, user.UID, updateUserParams)
+		if err != nil {
+			return "", err
+		}
+		return updatedUser.UID, nil
+	}
+
+	params := (&auth.UserToCreate{}).Email(email).Password(pw).EmailVerified(false)
+	newUser, err := repo.auth.CreateUser(ctx, params)
+	if err != nil {
+		return "", err
+	}
+	return newUser.UID, nil
+}
+
+// Login isn't supported, since clients sign in through the Firebase SDK and get their tokens from Firebase.
+func (repo FirebaseIdentityProvider) Login(ctx context.Context, req accounts.LoginRequest) (accounts.LoginResponse, error) {
+	return accounts.LoginResponse{}, contracts.ErrLoginNotSupported
+}
+
+func (repo FirebaseIdentityProvider) DeleteUser(ctx context.Context, userID string) error {
+	return repo.auth.DeleteUser(ctx, userID)
+}
+
+func (repo FirebaseIdentityProvider) DisableUser(ctx context.Context, userID string) error {
+	usr, err := repo.auth.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if usr.Disabled {
+		return contracts.ErrUserAlreadyDisabled
+	}
+	updateUserParams := (&auth.UserToUpdate{}).Disabled(true)
+	_, err = repo.auth.UpdateUser(ctx, userID, updateUserParams)
+	return err
+}
+
+func (repo FirebaseIdentityProvider) EnableUser(ctx context.Context, userID string) error {
+	usr, err := repo.auth.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !usr.Disabled {
+		return contracts.ErrUserNotDisabled
+	}
+	updateUserParams := (&auth.UserToUpdate{}).Disabled(false)
+	_, err = repo.auth.UpdateUser(ctx, userID, updateUserParams)
+	return err
+}
+
+func (repo FirebaseIdentityProvider) UserIsVerified(ctx context.Context, userID string) (bool, error) {
+	user, err := repo.auth.GetUser(ctx, userID)
+	if err != nil {
+		return false, contracts.ErrUserNotFound
+	}
+	return user.EmailVerified, nil
+}
+
+func (repo FirebaseIdentityProvider) VerifyUser(ctx context.Context, userID string) error {
+	user, err := repo.auth.GetUser(ctx, userID)
+	if err == nil && user != nil {
+		if user.EmailVerified {
+			return contracts.ErrUserAlreadyVerified
+		}
+
+		updateUserParams := (&auth.UserToUpdate{}).EmailVerified(true)
+		_, err := repo.auth.UpdateUser(ctx, user.UID, updateUserParams)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+	return contracts.ErrUserNotFound
+}
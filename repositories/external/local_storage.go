@@ -0,0 +1,119 @@
+package external
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+)
+
+// LocalBlobStorage keeps blobs on disk under root, for running the service without a bucket. Its signed URLs point
+// to baseUrl, where the service serves blobs after checking the signature with VerifySignature.
+type LocalBlobStorage struct {
+	root    string
+	baseUrl string
+	secret  []byte
+}
+
+func NewLocalBlobStorage(root string, baseUrl string, secret []byte) (LocalBlobStorage, error) {
+	if len(secret) == 0 {
+		return LocalBlobStorage{}, errors.New("local blob storage needs a signing secret")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return LocalBlobStorage{}, err
+	}
+	return LocalBlobStorage{root: root, baseUrl: strings.TrimRight(baseUrl, "/"), secret: secret}, nil
+}
+
+func (s LocalBlobStorage) Put(_ context.Context, path string, data []byte, _ string) error {
+	filePath := s.filePath(path)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, data, 0o644)
+}
+
+func (s LocalBlobStorage) Stat(_ context.Context, path string) (BlobAttrs, error) {
+	info, err := os.Stat(s.filePath(path))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return BlobAttrs{}, contracts.ErrBlobNotFound
+		}
+		return BlobAttrs{}, err
+	}
+	return BlobAttrs{Size: info.Size(), UpdatedAt: info.ModTime()}, nil
+}
+
+func (s LocalBlobStorage) Delete(_ context.Context, path string) error {
+	err := os.Remove(s.filePath(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return contracts.ErrBlobNotFound
+	}
+	return err
+}
+
+// Open returns the blob's file, for serving it.
+func (s LocalBlobStorage) Open(path string) (*os.File, error) {
+	file, err := os.Open(s.filePath(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, contracts.ErrBlobNotFound
+	}
+	return file, err
+}
+
+func (s LocalBlobStorage) SignedGetUrl(path string, expiresIn time.Duration) (string, error) {
+	return s.signedUrl("GET", path, expiresIn), nil
+}
+
+func (s LocalBlobStorage) SignedPutUrl(path string, _ string, expiresIn time.Duration) (string, error) {
+	return s.signedUrl("PUT", path, expiresIn), nil
+}
+
+// VerifySignature checks the expires and signature query params of a URL returned by SignedGetUrl or SignedPutUrl.
+func (s LocalBlobStorage) VerifySignature(method string, path string, expires string, signature string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return contracts.ErrInvalidBlobSignature
+	}
+
+	expected := s.sign(method, cleanBlobPath(path), expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return contracts.ErrInvalidBlobSignature
+	}
+	return nil
+}
+
+func (s LocalBlobStorage) signedUrl(method string, path string, expiresIn time.Duration) string {
+	path = cleanBlobPath(path)
+	expiresAt := time.Now().Add(expiresIn).Unix()
+	query := url.Values{
+		"expires":   {strconv.FormatInt(expiresAt, 10)},
+		"signature": {s.sign(method, path, expiresAt)},
+	}
+	return s.baseUrl + "/" + path + "?" + query.Encode()
+}
+
+func (s LocalBlobStorage) sign(method string, path string, expiresAt int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(method + "\n" + path + "\n" + strconv.FormatInt(expiresAt, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s LocalBlobStorage) filePath(path string) string {
+	return filepath.Join(s.root, filepath.FromSlash(cleanBlobPath(path)))
+}
+
+// cleanBlobPath resolves dot segments, so that paths can't point outside of the storage root.
+func cleanBlobPath(path string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean("/"+path)), "/")
+}
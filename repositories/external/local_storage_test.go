@@ -0,0 +1,62 @@
+package external
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBlobStorage_PutStatDelete(t *testing.T) {
+	ctx := context.Background()
+	blobs, err := NewLocalBlobStorage(t.TempDir(), "http://localhost/v1/blobs", []byte("secret"))
+	assert.NoError(t, err)
+
+	_, err = blobs.Stat(ctx, "profile_pictures/a/profile.png")
+	assert.ErrorIs(t, err, contracts.ErrBlobNotFound)
+
+	assert.NoError(t, blobs.Put(ctx, "profile_pictures/a/profile.png", []byte("picture"), "image/png"))
+	attrs, err := blobs.Stat(ctx, "profile_pictures/a/profile.png")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), attrs.Size)
+
+	assert.NoError(t, blobs.Delete(ctx, "profile_pictures/a/profile.png"))
+	assert.ErrorIs(t, blobs.Delete(ctx, "profile_pictures/a/profile.png"), contracts.ErrBlobNotFound)
+}
+
+func TestLocalBlobStorage_StaysInsideRoot(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	blobs, err := NewLocalBlobStorage(root, "http://localhost/v1/blobs", []byte("secret"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, blobs.Put(ctx, "../../escaped.txt", []byte("data"), "text/plain"))
+	file, err := blobs.Open("escaped.txt")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(file.Name(), root))
+	_ = file.Close()
+}
+
+func TestLocalBlobStorage_SignedUrls(t *testing.T) {
+	blobs, err := NewLocalBlobStorage(t.TempDir(), "http://localhost/v1/blobs/", []byte("secret"))
+	assert.NoError(t, err)
+
+	signedUrl, err := blobs.SignedGetUrl("verification_videos/a/video.mp4", time.Hour)
+	assert.NoError(t, err)
+	parsed, err := url.Parse(signedUrl)
+	assert.NoError(t, err)
+	assert.Equal(t, "/v1/blobs/verification_videos/a/video.mp4", parsed.Path)
+
+	expires, signature := parsed.Query().Get("expires"), parsed.Query().Get("signature")
+	assert.NoError(t, blobs.VerifySignature("GET", "/verification_videos/a/video.mp4", expires, signature))
+	assert.ErrorIs(t, blobs.VerifySignature("PUT", "/verification_videos/a/video.mp4", expires, signature), contracts.ErrInvalidBlobSignature)
+	assert.ErrorIs(t, blobs.VerifySignature("GET", "/verification_videos/b/video.mp4", expires, signature), contracts.ErrInvalidBlobSignature)
+
+	expiredUrl, _ := blobs.SignedPutUrl("verification_videos/a/video.mp4", "video/mp4", -time.Minute)
+	parsed, _ = url.Parse(expiredUrl)
+	assert.ErrorIs(t, blobs.VerifySignature("PUT", "verification_videos/a/video.mp4", parsed.Query().Get("expires"), parsed.Query().Get("signature")), contracts.ErrInvalidBlobSignature)
+}
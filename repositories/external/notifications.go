@@ -16,7 +16,8 @@ import (
 //go:generate mockery --name Notifications
 type Notifications interface {
 	SendFollowersNotification(ctx context.Context, follower models.User, followed models.User) error
-	SendCertificationNotification(ctx context.Context, userID string, certificationStatus string) error
+	SendCertificationNotification(ctx context.Context, certification models.Certification) error
+	SendCertificationExpiryReminder(ctx context.Context, certification models.Certification) error
 }
 
 type NotificationRepository struct {
@@ -30,7 +31,6 @@ func NewNotificationRepository(url string, logger *zap.Logger, version string) N
 }
 
 func (repo NotificationRepository) SendFollowersNotification(ctx context.Context, follower models.User, followed models.User) error {
-	url := repo.url + "/api/" + repo.version + "/notifications/push"
 	body := notificationBody{
 		ToUserID: []string{followed.ID},
 		Title:    "FiuFit",
@@ -48,44 +48,44 @@ func (repo NotificationRepository) SendFollowersNotification(ctx context.Context
 			},
 		},
 	}
-	jsonBody, err := json.Marshal(body)
-	if err != nil {
-		return err
-	}
-	bodyReader := bytes.NewBuffer(jsonBody)
-	res, err := utils.MakeRequest(http.MethodPost, url, bodyReader)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-	resBody, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return err
-	}
-	statusCode := res.StatusCode
-
-	if statusCode >= 400 {
-		err := contracts.UnwrapError(resBody)
-		return err
-	}
-	return nil
+	return repo.push(body)
 }
 
-func (repo NotificationRepository) SendCertificationNotification(ctx context.Context, userID string, certificationStatus string) error {
-	url := repo.url + "/api/" + repo.version + "/notifications/push"
-
+// SendCertificationNotification tells the trainer about a reviewed certification. Denials and revocations include the
+// reason and the reviewer notes, so the trainer knows what to fix before trying again.
+func (repo NotificationRepository) SendCertificationNotification(ctx context.Context, certification models.Certification) error {
 	var message string
 	var messageType string
-	if certificationStatus == models.CertificationStatusApproved {
+	params := map[string]interface{}{
+		"forceRefresh": true,
+	}
+	switch certification.Status {
+	case models.CertificationStatusApproved:
 		message = "Congratulations! Your profile is now verified"
 		messageType = "VERIFICATION_APPROVED"
-	} else {
+	case models.CertificationStatusExpired:
+		message = "Your trainer verification expired. Submit a renewal to get verified again"
+		messageType = "VERIFICATION_EXPIRED"
+	case models.CertificationStatusRevoked:
+		message = "Your trainer verification was revoked. You can submit a new verification petition"
+		if reason, ok := models.CertificationRevocationReasons[certification.RevocationReason]; ok {
+			message = "Your trainer verification was revoked: " + reason + ". You can submit a new verification petition"
+		}
+		messageType = "VERIFICATION_REVOKED"
+		params["revocationReason"] = certification.RevocationReason
+		params["reviewerNotes"] = certification.ReviewerNotes
+	default:
 		message = "Your verification petition was denied. Please try again"
+		if reason, ok := models.CertificationDenialReasons[certification.DenialReason]; ok {
+			message = "Your verification petition was denied: " + reason + ". Please try again"
+		}
 		messageType = "VERIFICATION_REJECTED"
+		params["denialReason"] = certification.DenialReason
+		params["reviewerNotes"] = certification.ReviewerNotes
 	}
 
 	body := notificationBody{
-		ToUserID: []string{userID},
+		ToUserID: []string{certification.UserID},
 		Title:    "FiuFit",
 		Subtitle: "Your verification status has been updated",
 		Body:     message,
@@ -93,11 +93,41 @@ func (repo NotificationRepository) SendCertificationNotification(ctx context.Con
 		Data: map[string]interface{}{
 			"redirectTo": "Profile Settings",
 			"type":       messageType,
-			"params": map[string]interface{}{
-				"forceRefresh": true,
-			},
+			"params":     params,
+		},
+	}
+	return repo.push(body)
+}
+
+// SendCertificationExpiryReminder tells the trainer that their certification is about to expire, so they can submit a
+// renewal in time.
+func (repo NotificationRepository) SendCertificationExpiryReminder(ctx context.Context, certification models.Certification) error {
+	params := map[string]interface{}{
+		"forceRefresh": true,
+	}
+	message := "Your trainer verification is about to expire. Submit a renewal to stay verified"
+	if certification.ExpiresAt != nil {
+		message = "Your trainer verification expires on " + certification.ExpiresAt.Format("January 2, 2006") + ". Submit a renewal to stay verified"
+		params["expiresAt"] = certification.ExpiresAt
+	}
+
+	body := notificationBody{
+		ToUserID: []string{certification.UserID},
+		Title:    "FiuFit",
+		Subtitle: "Your verification is about to expire",
+		Body:     message,
+		Sound:    "default",
+		Data: map[string]interface{}{
+			"redirectTo": "Profile Settings",
+			"type":       "VERIFICATION_EXPIRING",
+			"params":     params,
 		},
 	}
+	return repo.push(body)
+}
+
+func (repo NotificationRepository) push(body notificationBody) error {
+	url := repo.url + "/api/" + repo.version + "/notifications/push"
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return err
@@ -112,11 +142,9 @@ func (repo NotificationRepository) SendCertificationNotification(ctx context.Con
 	if err != nil {
 		return err
 	}
-	statusCode := res.StatusCode
 
-	if statusCode >= 400 {
-		err := contracts.UnwrapError(resBody)
-		return err
+	if res.StatusCode >= 400 {
+		return contracts.UnwrapError(resBody)
 	}
 	return nil
 }
@@ -0,0 +1,55 @@
+package external
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	pictureUrlExpiry = 7 * 24 * time.Hour
+	// signedUrlCacheTTL is shorter than pictureUrlExpiry, so cached URLs handed to clients stay valid for days.
+	signedUrlCacheTTL  = 24 * time.Hour
+	signedUrlCacheSize = 10000
+)
+
+// signedUrlCache keeps signed URLs by object path and version, since every listed user needs its picture signed.
+type signedUrlCache struct {
+	mu      sync.RWMutex
+	entries map[string]signedUrl
+}
+
+type signedUrl struct {
+	url       string
+	expiresAt time.Time
+}
+
+func newSignedUrlCache() *signedUrlCache {
+	return &signedUrlCache{entries: make(map[string]signedUrl)}
+}
+
+func (c *signedUrlCache) get(path string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[path]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return "", false
+	}
+	return entry.url, true
+}
+
+func (c *signedUrlCache) set(path string, url string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if len(c.entries) >= signedUrlCacheSize {
+		for cachedPath, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, cachedPath)
+			}
+		}
+		if len(c.entries) >= signedUrlCacheSize {
+			c.entries = make(map[string]signedUrl)
+		}
+	}
+	c.entries[path] = signedUrl{url: url, expiresAt: now.Add(signedUrlCacheTTL)}
+}
@@ -0,0 +1,22 @@
+package external
+
+import (
+	"context"
+	"time"
+)
+
+type BlobAttrs struct {
+	Size      int64
+	UpdatedAt time.Time
+}
+
+// BlobStorage stores media by slash separated paths. Missing blobs are reported with contracts.ErrBlobNotFound.
+//
+//go:generate mockery --name BlobStorage
+type BlobStorage interface {
+	Put(ctx context.Context, path string, data []byte, contentType string) error
+	Stat(ctx context.Context, path string) (BlobAttrs, error)
+	Delete(ctx context.Context, path string) error
+	SignedGetUrl(path string, expiresIn time.Duration) (string, error)
+	SignedPutUrl(path string, contentType string, expiresIn time.Duration) (string, error)
+}
@@ -0,0 +1,204 @@
+package repositories
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/accounts"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	identityIDLength   = 28
+	identityIDAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// IdentityRepository is a self-contained external.IdentityProvider, storing credentials in Postgres and issuing
+// its own tokens.
+type IdentityRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+	toker  utils.Toker
+}
+
+func NewIdentityRepository(db *gorm.DB, logger *zap.Logger, toker utils.Toker) IdentityRepository {
+	return IdentityRepository{db: db, logger: logger, toker: toker}
+}
+
+func (repo IdentityRepository) Register(ctx context.Context, req accounts.RegisterRequest) (string, error) {
+	email := strings.ToLower(req.Email)
+	hashedPassword, err := utils.HashPassword(req.Password)
+	if err != nil {
+		return "", err
+	}
+
+	identity, err := repo.getByEmail(ctx, email)
+	if err == nil {
+		if identity.EmailVerified {
+			return "", contracts.ErrUserAlreadyExists
+		}
+
+		err = repo.update(ctx, identity.UserID, "password", hashedPassword)
+		if err != nil {
+			return "", err
+		}
+		return identity.UserID, nil
+	}
+	if !errors.Is(err, contracts.ErrUserNotFound) {
+		return "", err
+	}
+
+	userID, err := newIdentityID()
+	if err != nil {
+		return "", err
+	}
+
+	identity = models.Identity{
+		UserID:    userID,
+		Email:     email,
+		Password:  hashedPassword,
+		CreatedAt: time.Now(),
+	}
+	result := repo.db.WithContext(ctx).Create(&identity)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return "", contracts.ErrUserAlreadyExists
+		}
+		repo.logger.Error("Unable to create identity", zap.Error(result.Error), zap.String("email", email))
+		return "", result.Error
+	}
+	return identity.UserID, nil
+}
+
+// unknownEmailPasswordHash is compared against on logins with unknown emails, so they take as long as wrong passwords.
+const unknownEmailPasswordHash = "$2a$10$RQi5eq3r38rPIwrdQSQvZuSQ9vCnuf1KkRs5DF1J1PX2HQnuhqz5e"
+
+// Login fails with contracts.ErrInvalidPassword for unknown emails too, so it can't tell which emails are registered.
+func (repo IdentityRepository) Login(ctx context.Context, req accounts.LoginRequest) (accounts.LoginResponse, error) {
+	identity, err := repo.getByEmail(ctx, strings.ToLower(req.Email))
+	if errors.Is(err, contracts.ErrUserNotFound) {
+		_ = utils.ValidatePassword(req.Password, unknownEmailPasswordHash)
+		return accounts.LoginResponse{}, contracts.ErrInvalidPassword
+	}
+	if err != nil {
+		return accounts.LoginResponse{}, err
+	}
+
+	if err := utils.ValidatePassword(req.Password, identity.Password); err != nil {
+		return accounts.LoginResponse{}, contracts.ErrInvalidPassword
+	}
+
+	if identity.Disabled {
+		return accounts.LoginResponse{}, contracts.ErrUserDisabled
+	}
+
+	token, err := repo.toker.CreateToken(identity.UserID, false)
+	if err != nil {
+		repo.logger.Error("Unable to generate JWT for user", zap.Error(err), zap.String("userID", identity.UserID))
+		return accounts.LoginResponse{}, err
+	}
+
+	return accounts.LoginResponse{Token: token}, nil
+}
+
+func (repo IdentityRepository) DeleteUser(ctx context.Context, userID string) error {
+	result := repo.db.WithContext(ctx).Delete(&models.Identity{}, "user_id = ?", userID)
+	if result.Error != nil {
+		repo.logger.Error("Unable to delete identity", zap.Error(result.Error), zap.String("userID", userID))
+		return result.Error
+	}
+	return nil
+}
+
+func (repo IdentityRepository) EnableUser(ctx context.Context, userID string) error {
+	identity, err := repo.getByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !identity.Disabled {
+		return contracts.ErrUserNotDisabled
+	}
+	return repo.update(ctx, userID, "disabled", false)
+}
+
+func (repo IdentityRepository) DisableUser(ctx context.Context, userID string) error {
+	identity, err := repo.getByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if identity.Disabled {
+		return contracts.ErrUserAlreadyDisabled
+	}
+	return repo.update(ctx, userID, "disabled", true)
+}
+
+func (repo IdentityRepository) UserIsVerified(ctx context.Context, userID string) (bool, error) {
+	identity, err := repo.getByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return identity.EmailVerified, nil
+}
+
+func (repo IdentityRepository) VerifyUser(ctx context.Context, userID string) error {
+	identity, err := repo.getByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if identity.EmailVerified {
+		return contracts.ErrUserAlreadyVerified
+	}
+	return repo.update(ctx, userID, "email_verified", true)
+}
+
+func (repo IdentityRepository) getByID(ctx context.Context, userID string) (models.Identity, error) {
+	return repo.first(ctx, "user_id = ?", userID)
+}
+
+func (repo IdentityRepository) getByEmail(ctx context.Context, email string) (models.Identity, error) {
+	return repo.first(ctx, "email = ?", email)
+}
+
+func (repo IdentityRepository) first(ctx context.Context, query string, arg string) (models.Identity, error) {
+	var identity models.Identity
+	result := repo.db.WithContext(ctx).First(&identity, query, arg)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Identity{}, contracts.ErrUserNotFound
+		}
+		repo.logger.Error("Unable to get identity", zap.Error(result.Error), zap.String("value", arg))
+		return models.Identity{}, result.Error
+	}
+	return identity, nil
+}
+
+func (repo IdentityRepository) update(ctx context.Context, userID string, column string, value interface{}) error {
+	result := repo.db.WithContext(ctx).Model(&models.Identity{}).Where("user_id = ?", userID).Update(column, value)
+	if result.Error != nil {
+		repo.logger.Error("Unable to update identity", zap.Error(result.Error), zap.String("userID", userID), zap.String("column", column))
+		return result.Error
+	}
+	return nil
+}
+
+// newIdentityID generates IDs shaped like Firebase UIDs, so both providers can share the users table.
+func newIdentityID() (string, error) {
+	id := make([]byte, identityIDLength)
+	max := big.NewInt(int64(len(identityIDAlphabet)))
+	for i := range id {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		id[i] = identityIDAlphabet[n.Int64()]
+	}
+	return string(id), nil
+}
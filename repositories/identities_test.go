@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/accounts"
+	utilMocks "github.com/fiufit/users/utils/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestIdentityRepository_RegisterAndLogin(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	toker := new(utilMocks.Toker)
+	repo := NewIdentityRepository(testSuite.DB, zaptest.NewLogger(t), toker)
+
+	userID, err := repo.Register(ctx, accounts.RegisterRequest{Email: "Test@fiufit.com", Password: "password"})
+	assert.NoError(t, err)
+	assert.Len(t, userID, identityIDLength)
+
+	toker.On("CreateToken", userID, false).Return("token", nil)
+	res, err := repo.Login(ctx, accounts.LoginRequest{Email: "test@fiufit.com", Password: "password"})
+	assert.NoError(t, err)
+	assert.Equal(t, "token", res.Token)
+
+	_, err = repo.Login(ctx, accounts.LoginRequest{Email: "test@fiufit.com", Password: "wrong"})
+	assert.ErrorIs(t, err, contracts.ErrInvalidPassword)
+
+	_, err = repo.Login(ctx, accounts.LoginRequest{Email: "other@fiufit.com", Password: "password"})
+	assert.ErrorIs(t, err, contracts.ErrInvalidPassword)
+}
+
+func TestIdentityRepository_RegisterUnverifiedAgain(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	repo := NewIdentityRepository(testSuite.DB, zaptest.NewLogger(t), new(utilMocks.Toker))
+
+	userID, err := repo.Register(ctx, accounts.RegisterRequest{Email: "test@fiufit.com", Password: "password"})
+	assert.NoError(t, err)
+
+	sameID, err := repo.Register(ctx, accounts.RegisterRequest{Email: "test@fiufit.com", Password: "newpassword"})
+	assert.NoError(t, err)
+	assert.Equal(t, userID, sameID)
+
+	assert.NoError(t, repo.VerifyUser(ctx, userID))
+	assert.ErrorIs(t, repo.VerifyUser(ctx, userID), contracts.ErrUserAlreadyVerified)
+
+	_, err = repo.Register(ctx, accounts.RegisterRequest{Email: "test@fiufit.com", Password: "password"})
+	assert.ErrorIs(t, err, contracts.ErrUserAlreadyExists)
+}
+
+func TestIdentityRepository_DisableAndEnable(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	repo := NewIdentityRepository(testSuite.DB, zaptest.NewLogger(t), new(utilMocks.Toker))
+
+	userID, err := repo.Register(ctx, accounts.RegisterRequest{Email: "test@fiufit.com", Password: "password"})
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, repo.EnableUser(ctx, userID), contracts.ErrUserNotDisabled)
+	assert.NoError(t, repo.DisableUser(ctx, userID))
+	assert.ErrorIs(t, repo.DisableUser(ctx, userID), contracts.ErrUserAlreadyDisabled)
+
+	_, err = repo.Login(ctx, accounts.LoginRequest{Email: "test@fiufit.com", Password: "password"})
+	assert.ErrorIs(t, err, contracts.ErrUserDisabled)
+
+	assert.NoError(t, repo.EnableUser(ctx, userID))
+	assert.NoError(t, repo.DeleteUser(ctx, userID))
+
+	_, err = repo.UserIsVerified(ctx, userID)
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
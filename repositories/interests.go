@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/database"
+	"github.com/fiufit/users/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const interestCacheTTL = 5 * time.Minute
+
+//go:generate mockery --name Interests
+type Interests interface {
+	Create(ctx context.Context, interest models.Interest) (models.Interest, error)
+	Get(ctx context.Context, req interests.GetInterestsRequest) (interests.GetInterestsResponse, error)
+	GetByName(ctx context.Context, name string) (models.Interest, error)
+	GetActive(ctx context.Context) ([]models.Interest, error)
+	Update(ctx context.Context, interest models.Interest) (models.Interest, error)
+	SeedDefaults(ctx context.Context) error
+}
+
+type InterestRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+	cache  *interestCache
+}
+
+// interestCache keeps the active catalog in memory, since it is read on every register and profile update.
+type interestCache struct {
+	mu        sync.RWMutex
+	interests []models.Interest
+	expiresAt time.Time
+}
+
+func NewInterestRepository(db *gorm.DB, logger *zap.Logger) InterestRepository {
+	return InterestRepository{db: db, logger: logger, cache: &interestCache{}}
+}
+
+func (repo InterestRepository) Create(ctx context.Context, interest models.Interest) (models.Interest, error) {
+	db := repo.db.WithContext(ctx)
+	result := db.Create(&interest)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return models.Interest{}, contracts.ErrInterestAlreadyExists
+		}
+		repo.logger.Error("Unable to create interest", zap.Error(result.Error), zap.Any("interest", interest))
+		return models.Interest{}, result.Error
+	}
+
+	repo.cache.invalidate()
+	return interest, nil
+}
+
+func (repo InterestRepository) Get(ctx context.Context, req interests.GetInterestsRequest) (interests.GetInterestsResponse, error) {
+	db := repo.db.WithContext(ctx)
+	var res []models.Interest
+
+	if !req.IncludeInactive {
+		db = db.Where("active = ?", true)
+	}
+
+	result := db.Order("name").Scopes(database.Paginate(res, &req.Pagination, db)).Preload("Translations").Find(&res)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get interests", zap.Error(result.Error), zap.Any("request", req))
+		return interests.GetInterestsResponse{}, result.Error
+	}
+
+	return interests.GetInterestsResponse{Interests: res, Pagination: req.Pagination}, nil
+}
+
+func (repo InterestRepository) GetByName(ctx context.Context, name string) (models.Interest, error) {
+	db := repo.db.WithContext(ctx)
+	var interest models.Interest
+
+	result := db.Preload("Translations").First(&interest, "name = ?", name)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Interest{}, contracts.ErrInterestNotFound
+		}
+		repo.logger.Error("Unable to get interest", zap.Error(result.Error), zap.String("name", name))
+		return models.Interest{}, result.Error
+	}
+
+	return interest, nil
+}
+
+func (repo InterestRepository) GetActive(ctx context.Context) ([]models.Interest, error) {
+	if cached, ok := repo.cache.get(); ok {
+		return cached, nil
+	}
+
+	db := repo.db.WithContext(ctx)
+	var active []models.Interest
+	result := db.Where("active = ?", true).Preload("Translations").Find(&active)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get active interests", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	repo.cache.set(active)
+	return active, nil
+}
+
+func (repo InterestRepository) Update(ctx context.Context, interest models.Interest) (models.Interest, error) {
+	db := repo.db.WithContext(ctx)
+
+	result := db.Session(&gorm.Session{FullSaveAssociations: true}).Save(&interest)
+	if result.Error != nil {
+		repo.logger.Error("Unable to update interest", zap.Error(result.Error), zap.Any("interest", interest))
+		return models.Interest{}, result.Error
+	}
+
+	repo.cache.invalidate()
+	return interest, nil
+}
+
+// SeedDefaults inserts models.DefaultInterests. Interests that already exist keep their values, except for rows
+// created before the catalog had display names, which get the default display name and icon.
+func (repo InterestRepository) SeedDefaults(ctx context.Context) error {
+	db := repo.db.WithContext(ctx)
+	defaults := make([]models.Interest, len(models.DefaultInterests))
+	copy(defaults, models.DefaultInterests)
+
+	result := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "interests.display_name IS NULL OR interests.display_name = ''"}}},
+		DoUpdates: clause.AssignmentColumns([]string{"display_name", "icon_key"}),
+	}).Create(&defaults)
+	if result.Error != nil {
+		repo.logger.Error("Unable to seed default interests", zap.Error(result.Error))
+		return result.Error
+	}
+
+	repo.cache.invalidate()
+	return nil
+}
+
+func (c *interestCache) get() ([]models.Interest, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.interests == nil || time.Now().After(c.expiresAt) {
+		return nil, false
+	}
+	return c.interests, true
+}
+
+func (c *interestCache) set(interests []models.Interest) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if interests == nil {
+		interests = []models.Interest{}
+	}
+	c.interests = interests
+	c.expiresAt = time.Now().Add(interestCacheTTL)
+}
+
+func (c *interestCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interests = nil
+}
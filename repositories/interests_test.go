@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestInterestRepository_Create_DuplicatedError(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewInterestRepository(db, zaptest.NewLogger(t))
+	db.Create(&models.Interest{Name: "yoga", Active: true})
+
+	_, err := repo.Create(ctx, models.Interest{Name: "yoga", Active: true})
+
+	assert.ErrorIs(t, err, contracts.ErrInterestAlreadyExists)
+}
+
+func TestInterestRepository_GetByName_NotFound(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	repo := NewInterestRepository(testSuite.DB, zaptest.NewLogger(t))
+
+	_, err := repo.GetByName(ctx, "yoga")
+
+	assert.ErrorIs(t, err, contracts.ErrInterestNotFound)
+}
+
+func TestInterestRepository_Get_FiltersInactive(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewInterestRepository(db, zaptest.NewLogger(t))
+	db.Create(&models.Interest{Name: "yoga", Active: true})
+	db.Create(&models.Interest{Name: "crossfit", Active: true})
+	db.Model(&models.Interest{}).Where("name = ?", "crossfit").Update("active", false)
+
+	active, err := repo.Get(ctx, interests.GetInterestsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, active.Interests, 1)
+
+	all, err := repo.Get(ctx, interests.GetInterestsRequest{IncludeInactive: true})
+	assert.NoError(t, err)
+	assert.Len(t, all.Interests, 2)
+}
+
+func TestInterestRepository_GetActive_CacheInvalidatedOnUpdate(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewInterestRepository(db, zaptest.NewLogger(t))
+	created, err := repo.Create(ctx, models.Interest{Name: "yoga", Active: true})
+	assert.NoError(t, err)
+
+	active, err := repo.GetActive(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, active, 1)
+
+	created.Active = false
+	_, err = repo.Update(ctx, created)
+	assert.NoError(t, err)
+
+	active, err = repo.GetActive(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, active, 0)
+}
+
+func TestInterestRepository_GetActive_DBError(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewInterestRepository(db, zaptest.NewLogger(t))
+	_ = db.AddError(errors.New("test error"))
+
+	_, err := repo.GetActive(ctx)
+	assert.Error(t, err)
+	db.Error = nil
+}
+
+func TestInterestRepository_SeedDefaults_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewInterestRepository(db, zaptest.NewLogger(t))
+	db.Create(&models.Interest{Name: "strength", Active: false})
+
+	err := repo.SeedDefaults(ctx)
+	assert.NoError(t, err)
+
+	var strength models.Interest
+	db.First(&strength, "name = ?", "strength")
+	assert.Equal(t, "Strength", strength.DisplayName)
+	assert.False(t, strength.Active)
+
+	var count int64
+	db.Model(&models.Interest{}).Count(&count)
+	assert.Equal(t, int64(len(models.DefaultInterests)), count)
+}
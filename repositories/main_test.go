@@ -14,9 +14,18 @@ func TestMain(m *testing.M) {
 	testSuite = testingUtils.NewTestSuite(
 		models.Administrator{},
 		models.User{},
+		models.Follow{},
 		models.Interest{},
+		models.InterestTranslation{},
 		models.Certification{},
 		models.VerificationPin{},
+		models.TrainerProfile{},
+		models.TrainerLink{},
+		models.TrainerReview{},
+		models.Measurement{},
+		models.NicknameChange{},
+		models.Identity{},
+		models.CertificationTransition{},
 	)
 
 	testResult := m.Run()
@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/database"
+	"github.com/fiufit/users/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+//go:generate mockery --name Measurements
+type Measurements interface {
+	Get(ctx context.Context, req ucontracts.GetMeasurementsRequest) (ucontracts.GetMeasurementsResponse, error)
+}
+
+// MeasurementRepository only reads measurements, they are written by UserRepository along with the user's body data.
+type MeasurementRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewMeasurementRepository(db *gorm.DB, logger *zap.Logger) MeasurementRepository {
+	return MeasurementRepository{db: db, logger: logger}
+}
+
+func (repo MeasurementRepository) Get(ctx context.Context, req ucontracts.GetMeasurementsRequest) (ucontracts.GetMeasurementsResponse, error) {
+	db := repo.db.WithContext(ctx)
+	var measurements []models.Measurement
+
+	db = db.Model(&models.Measurement{}).Where("user_id = ?", req.UserID)
+	db = filterMeasurementsByDate(db, req.From, req.To)
+	result := db.Scopes(database.Paginate(measurements, &req.Pagination, db)).Order("measured_at DESC").Find(&measurements)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get measurements", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetMeasurementsResponse{}, result.Error
+	}
+
+	for i := range measurements {
+		measurements[i].FillBMI()
+	}
+	res := ucontracts.GetMeasurementsResponse{Measurements: measurements, Pagination: req.Pagination}
+	if req.GroupBy == "" {
+		return res, nil
+	}
+
+	aggregates := repo.db.WithContext(ctx).Model(&models.Measurement{}).Where("user_id = ?", req.UserID)
+	result = filterMeasurementsByDate(aggregates, req.From, req.To).
+		Select(`DATE_TRUNC(?, measured_at) AS period, COUNT(*) AS count,
+			MIN(weight) AS min_weight, MAX(weight) AS max_weight, AVG(weight) AS avg_weight,
+			MIN(height) AS min_height, MAX(height) AS max_height, AVG(height) AS avg_height,
+			COALESCE(AVG(weight / POWER(height / 100.0, 2)) FILTER (WHERE height > 0), 0) AS avg_bmi`, req.GroupBy).
+		Group("period").
+		Order("period").
+		Scan(&res.Aggregates)
+	if result.Error != nil {
+		repo.logger.Error("Unable to aggregate measurements", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetMeasurementsResponse{}, result.Error
+	}
+
+	return res, nil
+}
+
+func filterMeasurementsByDate(db *gorm.DB, from *time.Time, to *time.Time) *gorm.DB {
+	if from != nil {
+		db = db.Where("measured_at >= ?", *from)
+	}
+	if to != nil {
+		db = db.Where("measured_at <= ?", *to)
+	}
+	return db
+}
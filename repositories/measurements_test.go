@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/fiufit/users/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestMeasurementRepository_UpdateRecordsMeasurement(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, mock.Anything, mock.Anything).Return("")
+	userRepo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+	repo := NewMeasurementRepository(db, zaptest.NewLogger(t))
+
+	user, err := userRepo.CreateUser(ctx, models.User{ID: "a", Nickname: "a", Height: 180, Weight: 80})
+	assert.NoError(t, err)
+
+	user.DisplayName = "A"
+	user, err = userRepo.Update(ctx, user)
+	assert.NoError(t, err)
+
+	user.Weight = 90.5
+	_, err = userRepo.Update(ctx, user)
+	assert.NoError(t, err)
+
+	res, err := repo.Get(ctx, ucontracts.GetMeasurementsRequest{UserID: "a"})
+	assert.NoError(t, err)
+	assert.Len(t, res.Measurements, 2)
+	assert.Equal(t, 90.5, res.Measurements[0].Weight)
+	assert.InDelta(t, 27.93, res.Measurements[0].BMI, 0.01)
+}
+
+func TestMeasurementRepository_Get_Aggregates(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewMeasurementRepository(db, zaptest.NewLogger(t))
+
+	january := time.Date(2023, time.January, 10, 0, 0, 0, 0, time.UTC)
+	measurements := []models.Measurement{
+		{UserID: "a", Height: 180, Weight: 80, MeasuredAt: january},
+		{UserID: "a", Height: 180, Weight: 90, MeasuredAt: january.AddDate(0, 0, 5)},
+		{UserID: "a", Height: 180, Weight: 70, MeasuredAt: january.AddDate(0, 1, 0)},
+		{UserID: "b", Height: 160, Weight: 50, MeasuredAt: january},
+	}
+	_ = db.Create(&measurements)
+
+	res, err := repo.Get(ctx, ucontracts.GetMeasurementsRequest{UserID: "a", GroupBy: ucontracts.MeasurementsGroupByMonth})
+	assert.NoError(t, err)
+	assert.Len(t, res.Measurements, 3)
+	assert.Len(t, res.Aggregates, 2)
+	assert.Equal(t, int64(2), res.Aggregates[0].Count)
+	assert.Equal(t, float64(85), res.Aggregates[0].AvgWeight)
+	assert.Equal(t, float64(70), res.Aggregates[1].MaxWeight)
+
+	from := january.AddDate(0, 0, 20)
+	res, err = repo.Get(ctx, ucontracts.GetMeasurementsRequest{UserID: "a", From: &from})
+	assert.NoError(t, err)
+	assert.Len(t, res.Measurements, 1)
+	assert.Empty(t, res.Aggregates)
+}
@@ -62,6 +62,30 @@ func (_m *Admins) GetByEmail(ctx context.Context, email string) (models.Administ
 	return r0, r1
 }
 
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Admins) GetByID(ctx context.Context, id uint) (models.Administrator, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 models.Administrator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (models.Administrator, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) models.Administrator); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Administrator)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewAdmins interface {
 	mock.TestingT
 	Cleanup(func())
@@ -8,6 +8,8 @@ import (
 	models "github.com/fiufit/users/models"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	users "github.com/fiufit/users/contracts/users"
 )
 
@@ -16,6 +18,70 @@ type Users struct {
 	mock.Mock
 }
 
+// BackfillLocations provides a mock function with given fields: ctx, afterID, batchSize
+func (_m *Users) BackfillLocations(ctx context.Context, afterID string, batchSize int) (int, string, error) {
+	ret := _m.Called(ctx, afterID, batchSize)
+
+	var r0 int
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (int, string, error)); ok {
+		return rf(ctx, afterID, batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) int); ok {
+		r0 = rf(ctx, afterID, batchSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) string); ok {
+		r1 = rf(ctx, afterID, batchSize)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(ctx, afterID, batchSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// BatchFollow provides a mock function with given fields: ctx, followerUser, req
+func (_m *Users) BatchFollow(ctx context.Context, followerUser models.User, req users.BatchFollowRequest) (users.BatchFollowResponse, []models.User, error) {
+	ret := _m.Called(ctx, followerUser, req)
+
+	var r0 users.BatchFollowResponse
+	var r1 []models.User
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.User, users.BatchFollowRequest) (users.BatchFollowResponse, []models.User, error)); ok {
+		return rf(ctx, followerUser, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.User, users.BatchFollowRequest) users.BatchFollowResponse); ok {
+		r0 = rf(ctx, followerUser, req)
+	} else {
+		r0 = ret.Get(0).(users.BatchFollowResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.User, users.BatchFollowRequest) []models.User); ok {
+		r1 = rf(ctx, followerUser, req)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.User, users.BatchFollowRequest) error); ok {
+		r2 = rf(ctx, followerUser, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CreateUser provides a mock function with given fields: ctx, user
 func (_m *Users) CreateUser(ctx context.Context, user models.User) (models.User, error) {
 	ret := _m.Called(ctx, user)
@@ -54,13 +120,13 @@ func (_m *Users) DeleteUser(ctx context.Context, userID string) error {
 	return r0
 }
 
-// FollowUser provides a mock function with given fields: ctx, followedUser, followerUser
-func (_m *Users) FollowUser(ctx context.Context, followedUser models.User, followerUser models.User) error {
-	ret := _m.Called(ctx, followedUser, followerUser)
+// FollowUser provides a mock function with given fields: ctx, followedUser, followerUser, source
+func (_m *Users) FollowUser(ctx context.Context, followedUser models.User, followerUser models.User, source string) error {
+	ret := _m.Called(ctx, followedUser, followerUser, source)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.User, models.User) error); ok {
-		r0 = rf(ctx, followedUser, followerUser)
+	if rf, ok := ret.Get(0).(func(context.Context, models.User, models.User, string) error); ok {
+		r0 = rf(ctx, followedUser, followerUser, source)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -164,6 +230,30 @@ func (_m *Users) GetByNickname(ctx context.Context, nickname string) (models.Use
 	return r0, r1
 }
 
+// GetFollowSuggestions provides a mock function with given fields: ctx, req
+func (_m *Users) GetFollowSuggestions(ctx context.Context, req users.GetFollowSuggestionsRequest) (users.GetFollowSuggestionsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 users.GetFollowSuggestionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, users.GetFollowSuggestionsRequest) (users.GetFollowSuggestionsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, users.GetFollowSuggestionsRequest) users.GetFollowSuggestionsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(users.GetFollowSuggestionsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, users.GetFollowSuggestionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFollowed provides a mock function with given fields: ctx, req
 func (_m *Users) GetFollowed(ctx context.Context, req users.GetFollowedUsersRequest) (users.GetFollowedUsersResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -212,6 +302,146 @@ func (_m *Users) GetFollowers(ctx context.Context, request users.GetUserFollower
 	return r0, r1
 }
 
+// GetMutuals provides a mock function with given fields: ctx, req
+func (_m *Users) GetMutuals(ctx context.Context, req users.GetMutualsRequest) (users.GetMutualsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 users.GetMutualsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, users.GetMutualsRequest) (users.GetMutualsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, users.GetMutualsRequest) users.GetMutualsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(users.GetMutualsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, users.GetMutualsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRecommendations provides a mock function with given fields: ctx, req
+func (_m *Users) GetRecommendations(ctx context.Context, req users.GetUserRecommendationsRequest) (users.GetUserRecommendationsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 users.GetUserRecommendationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, users.GetUserRecommendationsRequest) (users.GetUserRecommendationsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, users.GetUserRecommendationsRequest) users.GetUserRecommendationsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(users.GetUserRecommendationsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, users.GetUserRecommendationsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRelationships provides a mock function with given fields: ctx, userID, otherUserIDs
+func (_m *Users) GetRelationships(ctx context.Context, userID string, otherUserIDs []string) ([]users.Relationship, error) {
+	ret := _m.Called(ctx, userID, otherUserIDs)
+
+	var r0 []users.Relationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]users.Relationship, error)); ok {
+		return rf(ctx, userID, otherUserIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []users.Relationship); ok {
+		r0 = rf(ctx, userID, otherUserIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]users.Relationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, userID, otherUserIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileFollowCounts provides a mock function with given fields: ctx
+func (_m *Users) ReconcileFollowCounts(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDisabled provides a mock function with given fields: ctx, userID, disabled
+func (_m *Users) SetDisabled(ctx context.Context, userID string, disabled bool) error {
+	ret := _m.Called(ctx, userID, disabled)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, userID, disabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetPictureUpdatedAt provides a mock function with given fields: ctx, userID, updatedAt
+func (_m *Users) SetPictureUpdatedAt(ctx context.Context, userID string, updatedAt time.Time) error {
+	ret := _m.Called(ctx, userID, updatedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, userID, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetNickname provides a mock function with given fields: ctx, userID, nickname
+func (_m *Users) SetNickname(ctx context.Context, userID string, nickname string) error {
+	ret := _m.Called(ctx, userID, nickname)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, nickname)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UnfollowUser provides a mock function with given fields: ctx, followedUserID, followerUserID
 func (_m *Users) UnfollowUser(ctx context.Context, followedUserID string, followerUserID string) error {
 	ret := _m.Called(ctx, followedUserID, followerUserID)
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/fiufit/users/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+//go:generate mockery --name NicknameChanges
+type NicknameChanges interface {
+	GetByUser(ctx context.Context, userID string, since time.Time) ([]models.NicknameChange, error)
+	GetByNickname(ctx context.Context, nickname string, since time.Time) ([]models.NicknameChange, error)
+}
+
+// NicknameChangeRepository only reads nickname changes, they are written by UserRepository when a user is renamed.
+type NicknameChangeRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewNicknameChangeRepository(db *gorm.DB, logger *zap.Logger) NicknameChangeRepository {
+	return NicknameChangeRepository{db: db, logger: logger}
+}
+
+// GetByUser returns the nicknames released by userID since the given time, newest first.
+func (repo NicknameChangeRepository) GetByUser(ctx context.Context, userID string, since time.Time) ([]models.NicknameChange, error) {
+	db := repo.db.WithContext(ctx)
+	var changes []models.NicknameChange
+
+	result := db.Where("user_id = ? AND changed_at >= ?", userID, since).Order("changed_at DESC").Find(&changes)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get nickname changes", zap.Error(result.Error), zap.String("userID", userID))
+		return nil, result.Error
+	}
+
+	return changes, nil
+}
+
+// GetByNickname returns who released nickname, ignoring case, since the given time, newest first.
+func (repo NicknameChangeRepository) GetByNickname(ctx context.Context, nickname string, since time.Time) ([]models.NicknameChange, error) {
+	db := repo.db.WithContext(ctx)
+	var changes []models.NicknameChange
+
+	result := db.Where("LOWER(nickname) = LOWER(?) AND changed_at >= ?", nickname, since).Order("changed_at DESC").Find(&changes)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get nickname changes", zap.Error(result.Error), zap.String("nickname", nickname))
+		return nil, result.Error
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/fiufit/users/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestNicknameChangeRepository_UpdateRecordsChange(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, mock.Anything, mock.Anything).Return("")
+	userRepo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+	repo := NewNicknameChangeRepository(db, zaptest.NewLogger(t))
+	since := time.Now().Add(-time.Hour)
+
+	user, err := userRepo.CreateUser(ctx, models.User{ID: "a", Nickname: "coach", Height: 180, Weight: 80})
+	assert.NoError(t, err)
+
+	user.Nickname = "Coach"
+	user, err = userRepo.Update(ctx, user)
+	assert.NoError(t, err)
+
+	changes, err := repo.GetByUser(ctx, "a", since)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	user.Nickname = "trainer"
+	_, err = userRepo.Update(ctx, user)
+	assert.NoError(t, err)
+
+	changes, err = repo.GetByNickname(ctx, "COACH", since)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "a", changes[0].UserID)
+	assert.Equal(t, "Coach", changes[0].Nickname)
+
+	changes, err = repo.GetByUser(ctx, "a", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestNicknameChangeRepository_SetNicknameDoesNotRedirect(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, mock.Anything, mock.Anything).Return("")
+	userRepo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+	repo := NewNicknameChangeRepository(db, zaptest.NewLogger(t))
+	since := time.Now().Add(-time.Hour)
+
+	_, err := userRepo.CreateUser(ctx, models.User{ID: "a", Nickname: "badword", Height: 180, Weight: 80})
+	assert.NoError(t, err)
+
+	err = userRepo.SetNickname(ctx, "a", "renamed")
+	assert.NoError(t, err)
+
+	changes, err := repo.GetByNickname(ctx, "badword", since)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	_, err = userRepo.GetByNickname(ctx, "badword")
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+
+	user, err := userRepo.GetByNickname(ctx, "renamed")
+	assert.NoError(t, err)
+	assert.Equal(t, "a", user.ID)
+}
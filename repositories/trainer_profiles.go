@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+//go:generate mockery --name TrainerProfiles
+type TrainerProfiles interface {
+	GetByUserID(ctx context.Context, userID string) (models.TrainerProfile, error)
+	Upsert(ctx context.Context, profile models.TrainerProfile) (models.TrainerProfile, error)
+}
+
+type TrainerProfileRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewTrainerProfileRepository(db *gorm.DB, logger *zap.Logger) TrainerProfileRepository {
+	return TrainerProfileRepository{db: db, logger: logger}
+}
+
+func (repo TrainerProfileRepository) GetByUserID(ctx context.Context, userID string) (models.TrainerProfile, error) {
+	db := repo.db.WithContext(ctx)
+	var profile models.TrainerProfile
+
+	result := db.Preload("Specialties.Translations").Preload("Links").First(&profile, "user_id = ?", userID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.TrainerProfile{}, contracts.ErrTrainerProfileNotFound
+		}
+		repo.logger.Error("Unable to get trainer profile", zap.Error(result.Error), zap.String("userID", userID))
+		return models.TrainerProfile{}, result.Error
+	}
+
+	if err := fillTrainerProfileDetails(db, &profile); err != nil {
+		repo.logger.Error("Unable to get trainer profile details", zap.Error(err), zap.String("userID", userID))
+		return models.TrainerProfile{}, err
+	}
+	return profile, nil
+}
+
+// Upsert creates or fully replaces the trainer profile, including its specialties and links.
+func (repo TrainerProfileRepository) Upsert(ctx context.Context, profile models.TrainerProfile) (models.TrainerProfile, error) {
+	db := repo.db.WithContext(ctx)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Specialties", "Links").Save(&profile).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&profile).Association("Specialties").Replace(profile.Specialties); err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", profile.UserID).Delete(&models.TrainerLink{}).Error; err != nil {
+			return err
+		}
+		if len(profile.Links) == 0 {
+			return nil
+		}
+		return tx.Create(&profile.Links).Error
+	})
+
+	if err != nil {
+		repo.logger.Error("Unable to save trainer profile", zap.Error(err), zap.Any("profile", profile))
+		return models.TrainerProfile{}, err
+	}
+
+	if err := fillTrainerProfileDetails(db, &profile); err != nil {
+		repo.logger.Error("Unable to get trainer profile details", zap.Error(err), zap.String("userID", profile.UserID))
+		return models.TrainerProfile{}, err
+	}
+	return profile, nil
+}
+
+// fillTrainerProfileDetails sets the approved certifications and the rating summary of the profile's trainer.
+func fillTrainerProfileDetails(db *gorm.DB, profile *models.TrainerProfile) error {
+	profile.Certifications = []models.TrainerCertification{}
+	err := db.Model(&models.Certification{}).
+		Select("id, COALESCE(reviewed_at, updated_at) AS approved_at").
+		Where("user_id = ? AND status = ?", profile.UserID, models.CertificationStatusApproved).
+		Order("approved_at DESC").
+		Scan(&profile.Certifications).Error
+	if err != nil {
+		return err
+	}
+
+	var summary struct {
+		AverageRating float64
+		ReviewCount   int64
+	}
+	err = db.Model(&models.TrainerReview{}).
+		Select("COALESCE(AVG(rating), 0) AS average_rating, COUNT(*) AS review_count").
+		Where("trainer_id = ?", profile.UserID).
+		Scan(&summary).Error
+	profile.AverageRating = summary.AverageRating
+	profile.ReviewCount = summary.ReviewCount
+	return err
+}
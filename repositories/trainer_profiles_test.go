@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/fiufit/users/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTrainerProfileRepository_GetByUserID_NotFound(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewTrainerProfileRepository(db, zaptest.NewLogger(t))
+
+	_, err := repo.GetByUserID(ctx, "unknown")
+
+	assert.ErrorIs(t, err, contracts.ErrTrainerProfileNotFound)
+}
+
+func TestTrainerProfileRepository_Upsert_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewTrainerProfileRepository(db, zaptest.NewLogger(t))
+
+	testUser := models.User{ID: "trainer", Nickname: "trainer", IsVerifiedTrainer: true}
+	_ = db.Create(&testUser)
+	interests := []models.Interest{{Name: "strength", Active: true}, {Name: "speed", Active: true}}
+	_ = db.Create(&interests)
+	reviewedAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+	testCert := models.Certification{UserID: testUser.ID, Status: models.CertificationStatusApproved, ReviewedAt: &reviewedAt}
+	_ = db.Create(&testCert)
+
+	profile := models.TrainerProfile{
+		UserID:      testUser.ID,
+		Bio:         "bio",
+		Specialties: interests,
+		Languages:   []string{"en", "es"},
+		Links:       []models.TrainerLink{{UserID: testUser.ID, Kind: "website", Url: "https://a.com"}},
+	}
+	_, err := repo.Upsert(ctx, profile)
+	assert.NoError(t, err)
+
+	profile.Bio = "new bio"
+	profile.Specialties = interests[:1]
+	profile.Links = []models.TrainerLink{{UserID: testUser.ID, Kind: "instagram", Url: "https://instagram.com/a"}}
+	_, err = repo.Upsert(ctx, profile)
+	assert.NoError(t, err)
+
+	saved, err := repo.GetByUserID(ctx, testUser.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "new bio", saved.Bio)
+	assert.Len(t, saved.Specialties, 1)
+	assert.Len(t, saved.Links, 1)
+	assert.Equal(t, "instagram", saved.Links[0].Kind)
+	assert.Len(t, saved.Certifications, 1)
+	assert.Equal(t, testCert.ID, saved.Certifications[0].ID)
+	assert.True(t, reviewedAt.Equal(saved.Certifications[0].ApprovedAt), "approval is dated by the review")
+}
+
+func TestUserRepository_Get_TrainerFilters(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, mock.Anything, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{
+		{ID: "a", Nickname: "a", IsVerifiedTrainer: true},
+		{ID: "b", Nickname: "b", IsVerifiedTrainer: true},
+		{ID: "c", Nickname: "c"},
+	}
+	_ = db.Create(&testUsers)
+	strength := models.Interest{Name: "strength", Active: true}
+	_ = db.Create(&strength)
+	profiles := []models.TrainerProfile{
+		{UserID: "a", YearsOfExperience: 10, Languages: []string{"es"}, Specialties: []models.Interest{strength}},
+		{UserID: "b", YearsOfExperience: 2, Languages: []string{"en"}},
+		{UserID: "c", YearsOfExperience: 20, Languages: []string{"es"}, Specialties: []models.Interest{strength}},
+	}
+	_ = db.Create(&profiles)
+
+	minExperience := uint(5)
+	res, err := repo.Get(ctx, users.GetUsersRequest{Specialty: "strength", MinExperience: &minExperience, TrainerLanguage: "es"})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Users, 1)
+	assert.Equal(t, "a", res.Users[0].ID)
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/database"
+	"github.com/fiufit/users/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+//go:generate mockery --name TrainerReviews
+type TrainerReviews interface {
+	Create(ctx context.Context, review models.TrainerReview) (models.TrainerReview, error)
+	Get(ctx context.Context, req trainers.GetReviewsRequest) (trainers.GetReviewsResponse, error)
+	GetByReviewer(ctx context.Context, trainerID string, reviewerID string) (models.TrainerReview, error)
+	Update(ctx context.Context, review models.TrainerReview) (models.TrainerReview, error)
+	Delete(ctx context.Context, reviewID uint) error
+}
+
+type TrainerReviewRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewTrainerReviewRepository(db *gorm.DB, logger *zap.Logger) TrainerReviewRepository {
+	return TrainerReviewRepository{db: db, logger: logger}
+}
+
+func (repo TrainerReviewRepository) Create(ctx context.Context, review models.TrainerReview) (models.TrainerReview, error) {
+	db := repo.db.WithContext(ctx)
+	result := db.Create(&review)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return models.TrainerReview{}, contracts.ErrReviewAlreadyExists
+		}
+		repo.logger.Error("Unable to create trainer review", zap.Error(result.Error), zap.Any("review", review))
+		return models.TrainerReview{}, result.Error
+	}
+
+	return review, nil
+}
+
+func (repo TrainerReviewRepository) Get(ctx context.Context, req trainers.GetReviewsRequest) (trainers.GetReviewsResponse, error) {
+	db := repo.db.WithContext(ctx)
+	var reviews []models.TrainerReview
+
+	db = db.Where("trainer_id = ?", req.TrainerID)
+	result := db.Order("updated_at DESC").Scopes(database.Paginate(reviews, &req.Pagination, db)).Find(&reviews)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get trainer reviews", zap.Error(result.Error), zap.Any("request", req))
+		return trainers.GetReviewsResponse{}, result.Error
+	}
+
+	return trainers.GetReviewsResponse{Reviews: reviews, Pagination: req.Pagination}, nil
+}
+
+func (repo TrainerReviewRepository) GetByReviewer(ctx context.Context, trainerID string, reviewerID string) (models.TrainerReview, error) {
+	db := repo.db.WithContext(ctx)
+	var review models.TrainerReview
+
+	result := db.First(&review, "trainer_id = ? AND reviewer_id = ?", trainerID, reviewerID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.TrainerReview{}, contracts.ErrReviewNotFound
+		}
+		repo.logger.Error("Unable to get trainer review", zap.Error(result.Error), zap.String("trainerID", trainerID), zap.String("reviewerID", reviewerID))
+		return models.TrainerReview{}, result.Error
+	}
+
+	return review, nil
+}
+
+func (repo TrainerReviewRepository) Update(ctx context.Context, review models.TrainerReview) (models.TrainerReview, error) {
+	db := repo.db.WithContext(ctx)
+	result := db.Save(&review)
+	if result.Error != nil {
+		repo.logger.Error("Unable to update trainer review", zap.Error(result.Error), zap.Any("review", review))
+		return models.TrainerReview{}, result.Error
+	}
+
+	return review, nil
+}
+
+func (repo TrainerReviewRepository) Delete(ctx context.Context, reviewID uint) error {
+	db := repo.db.WithContext(ctx)
+	result := db.Delete(&models.TrainerReview{}, reviewID)
+	if result.Error != nil {
+		repo.logger.Error("Unable to delete trainer review", zap.Error(result.Error), zap.Uint("reviewID", reviewID))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return contracts.ErrReviewNotFound
+	}
+
+	return nil
+}
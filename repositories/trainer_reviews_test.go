@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTrainerReviewRepository_Create_DBError(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB.WithContext(ctx)
+	repo := NewTrainerReviewRepository(db, zaptest.NewLogger(t))
+	db.AddError(errors.New("test db error"))
+
+	_, err := repo.Create(ctx, models.TrainerReview{})
+	assert.Error(t, err)
+	db.Error = nil
+}
+
+func TestTrainerReviewRepository_Create_AlreadyExists(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewTrainerReviewRepository(db, zaptest.NewLogger(t))
+
+	review := models.TrainerReview{TrainerID: "trainer", ReviewerID: "reviewer", Rating: 5}
+	_, err := repo.Create(ctx, review)
+	assert.NoError(t, err)
+
+	_, err = repo.Create(ctx, review)
+	assert.ErrorIs(t, err, contracts.ErrReviewAlreadyExists)
+}
+
+func TestTrainerReviewRepository_Get_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewTrainerReviewRepository(db, zaptest.NewLogger(t))
+
+	reviews := []models.TrainerReview{
+		{TrainerID: "trainer", ReviewerID: "a", Rating: 5},
+		{TrainerID: "trainer", ReviewerID: "b", Rating: 2},
+		{TrainerID: "other", ReviewerID: "a", Rating: 1},
+	}
+	_ = db.Create(&reviews)
+
+	res, err := repo.Get(ctx, trainers.GetReviewsRequest{TrainerID: "trainer"})
+	assert.NoError(t, err)
+	assert.Len(t, res.Reviews, 2)
+	assert.Equal(t, int64(2), res.Pagination.TotalRows)
+
+	profile := models.TrainerProfile{UserID: "trainer"}
+	assert.NoError(t, fillTrainerProfileDetails(db, &profile))
+	assert.Equal(t, 3.5, profile.AverageRating)
+	assert.Equal(t, int64(2), profile.ReviewCount)
+}
+
+func TestTrainerReviewRepository_Delete_NotFound(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	repo := NewTrainerReviewRepository(db, zaptest.NewLogger(t))
+
+	err := repo.Delete(ctx, 1)
+
+	assert.ErrorIs(t, err, contracts.ErrReviewNotFound)
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/fiufit/users/contracts"
 	ucontracts "github.com/fiufit/users/contracts/users"
@@ -12,8 +14,10 @@ import (
 	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/repositories/external"
 	"github.com/fiufit/users/utils"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 //go:generate mockery --name Users
@@ -25,34 +29,51 @@ type Users interface {
 	CreateUser(ctx context.Context, user models.User) (models.User, error)
 	Update(ctx context.Context, user models.User) (models.User, error)
 	DeleteUser(ctx context.Context, userID string) error
-	FollowUser(ctx context.Context, followedUser models.User, followerUser models.User) error
+	FollowUser(ctx context.Context, followedUser models.User, followerUser models.User, source string) error
 	UnfollowUser(ctx context.Context, followedUserID string, followerUserID string) error
+	BatchFollow(ctx context.Context, followerUser models.User, req ucontracts.BatchFollowRequest) (ucontracts.BatchFollowResponse, []models.User, error)
 	GetFollowers(ctx context.Context, request ucontracts.GetUserFollowersRequest) (ucontracts.GetUserFollowersResponse, error)
 	GetFollowed(ctx context.Context, req ucontracts.GetFollowedUsersRequest) (ucontracts.GetFollowedUsersResponse, error)
+	GetRecommendations(ctx context.Context, req ucontracts.GetUserRecommendationsRequest) (ucontracts.GetUserRecommendationsResponse, error)
+	GetFollowSuggestions(ctx context.Context, req ucontracts.GetFollowSuggestionsRequest) (ucontracts.GetFollowSuggestionsResponse, error)
+	GetRelationships(ctx context.Context, userID string, otherUserIDs []string) ([]ucontracts.Relationship, error)
+	GetMutuals(ctx context.Context, req ucontracts.GetMutualsRequest) (ucontracts.GetMutualsResponse, error)
+	SetDisabled(ctx context.Context, userID string, disabled bool) error
+	SetNickname(ctx context.Context, userID string, nickname string) error
+	SetPictureUpdatedAt(ctx context.Context, userID string, updatedAt time.Time) error
+	ReconcileFollowCounts(ctx context.Context) (int64, error)
+	BackfillLocations(ctx context.Context, afterID string, batchSize int) (int, string, error)
 }
 
 type UserRepository struct {
 	db             *gorm.DB
 	logger         *zap.Logger
-	auth           external.Firebase
+	firebase       external.Firebase
+	identity       external.IdentityProvider
 	reverseLocator *utils.ReverseLocator
 }
 
-func NewUserRepository(db *gorm.DB, logger *zap.Logger, auth external.Firebase, reverseLocator *utils.ReverseLocator) UserRepository {
-	return UserRepository{db: db, logger: logger, auth: auth, reverseLocator: reverseLocator}
+func NewUserRepository(db *gorm.DB, logger *zap.Logger, firebase external.Firebase, identity external.IdentityProvider, reverseLocator *utils.ReverseLocator) UserRepository {
+	return UserRepository{db: db, logger: logger, firebase: firebase, identity: identity, reverseLocator: reverseLocator}
 }
 
 func (repo UserRepository) CreateUser(ctx context.Context, user models.User) (models.User, error) {
 	db := repo.db.WithContext(ctx)
-	result := db.Create(&user)
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+	// has_picture is left unset, so pictures that clients still upload straight to storage are found later on.
+	repo.setUserLocation(&user)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+		return recordMeasurement(tx, models.User{}, user)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return models.User{}, contracts.ErrUserAlreadyExists
 		}
-		repo.logger.Error("Unable to create user", zap.Error(result.Error), zap.Any("user", user))
-		return models.User{}, result.Error
+		repo.logger.Error("Unable to create user", zap.Error(err), zap.Any("user", user))
+		return models.User{}, err
 	}
-	repo.fillUserLocation(&user)
 	repo.fillUserPicture(ctx, &user)
 	return user, nil
 }
@@ -60,7 +81,10 @@ func (repo UserRepository) CreateUser(ctx context.Context, user models.User) (mo
 func (repo UserRepository) GetByID(ctx context.Context, userID string) (models.User, error) {
 	db := repo.db.WithContext(ctx)
 	var usr models.User
-	result := db.Preload("Interests").First(&usr, "id = ?", userID)
+	result := db.Preload("Interests.Translations").
+		Preload("TrainerProfile.Specialties.Translations").
+		Preload("TrainerProfile.Links").
+		First(&usr, "id = ?", userID)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return models.User{}, contracts.ErrUserNotFound
@@ -69,7 +93,10 @@ func (repo UserRepository) GetByID(ctx context.Context, userID string) (models.U
 		return models.User{}, result.Error
 	}
 
-	repo.fillUserLocation(&usr)
+	if err := repo.fillTrainerProfile(db, &usr); err != nil {
+		return models.User{}, err
+	}
+
 	repo.fillUserPicture(ctx, &usr)
 	return usr, nil
 }
@@ -78,13 +105,30 @@ func (repo UserRepository) DeleteUser(ctx context.Context, userID string) error
 	db := repo.db.WithContext(ctx)
 	var usr models.User
 	err := db.Transaction(func(tx *gorm.DB) error {
-		result := tx.Delete(&usr, "id = ?", userID)
+		result := tx.First(&usr, "id = ?", userID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if !usr.Disabled {
+			err := repo.adjustCounterpartFollowCounts(tx, userID, -1)
+			if err != nil {
+				return err
+			}
+		}
+
+		result = tx.Exec("DELETE FROM user_followers WHERE user_id = ? OR follower_id = ?", userID, userID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&usr, "id = ?", userID)
 		if result.Error != nil {
 			return result.Error
 		}
-		fbError := repo.auth.DeleteUser(ctx, userID)
-		if fbError != nil {
-			return fbError
+		identityErr := repo.identity.DeleteUser(ctx, userID)
+		if identityErr != nil {
+			return identityErr
 		}
 		return nil
 	})
@@ -111,20 +155,20 @@ func (repo UserRepository) Get(ctx context.Context, req ucontracts.GetUsersReque
 	if req.Disabled != nil {
 		db = db.Where("disabled = ?", *req.Disabled)
 	}
+	if req.Specialty != "" || req.MinExperience != nil || req.TrainerLanguage != "" {
+		db = filterTrainers(db, req)
+	}
 	if req.Name != "" {
 		likeName := fmt.Sprintf("%v%%", strings.ToLower(req.Name))
 		db = db.Where("LOWER(display_name) LIKE ? OR LOWER(nickname) LIKE ?", likeName, likeName)
 	}
 
-	result := db.Scopes(database.Paginate(res, &req.Pagination, db)).Preload("Interests").Find(&res)
+	result := db.Scopes(database.Paginate(res, &req.Pagination, db)).Preload("Interests.Translations").Find(&res)
 	if result.Error != nil {
 		repo.logger.Error("Unable to get users with pagination", zap.Error(result.Error), zap.Any("request", req))
 		return ucontracts.GetUsersResponse{}, result.Error
 	}
-	for i := range res {
-		repo.fillUserLocation(&res[i])
-		repo.fillUserPicture(ctx, &res[i])
-	}
+	repo.fillUserPictures(ctx, res)
 
 	return ucontracts.GetUsersResponse{Users: res, Pagination: req.Pagination}, nil
 }
@@ -132,7 +176,7 @@ func (repo UserRepository) Get(ctx context.Context, req ucontracts.GetUsersReque
 func (repo UserRepository) GetByNickname(ctx context.Context, nickname string) (models.User, error) {
 	db := repo.db.WithContext(ctx)
 	var usr models.User
-	result := db.Where("nickname = ?", nickname).Preload("Interests").First(&usr)
+	result := db.Where("LOWER(nickname) = LOWER(?)", nickname).Preload("Interests.Translations").First(&usr)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -142,7 +186,6 @@ func (repo UserRepository) GetByNickname(ctx context.Context, nickname string) (
 		return models.User{}, result.Error
 	}
 
-	repo.fillUserLocation(&usr)
 	repo.fillUserPicture(ctx, &usr)
 	return usr, nil
 }
@@ -155,7 +198,7 @@ func (repo UserRepository) GetByDistance(ctx context.Context, req ucontracts.Get
 	result := db.
 		Scopes(database.Paginate(closestUsers, &req.Pagination, db)).
 		Where("earth_distance(ll_to_earth(?, ?), ll_to_earth(users.latitude, users.longitude)) <= ? AND users.ID != ?", req.Latitude, req.Longitude, req.Distance*1000, req.UserID).
-		Preload("Interests").
+		Preload("Interests.Translations").
 		Find(&closestUsers)
 
 	if result.Error != nil {
@@ -163,10 +206,7 @@ func (repo UserRepository) GetByDistance(ctx context.Context, req ucontracts.Get
 		return ucontracts.GetUsersResponse{}, result.Error
 	}
 
-	for i := range closestUsers {
-		repo.fillUserLocation(&closestUsers[i])
-		repo.fillUserPicture(ctx, &closestUsers[i])
-	}
+	repo.fillUserPictures(ctx, closestUsers)
 
 	return ucontracts.GetUsersResponse{Users: closestUsers, Pagination: req.Pagination}, nil
 }
@@ -174,26 +214,57 @@ func (repo UserRepository) GetByDistance(ctx context.Context, req ucontracts.Get
 func (repo UserRepository) Update(ctx context.Context, user models.User) (models.User, error) {
 	db := repo.db.WithContext(ctx)
 
-	err := db.Model(&user).Association("Interests").Replace(user.Interests)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var storedUser models.User
+		if err := tx.Select("id", "nickname", "height", "weight", "latitude", "longitude", "location_version").First(&storedUser, "id = ?", user.ID).Error; err != nil {
+			return err
+		}
+		if user.Latitude != storedUser.Latitude || user.Longitude != storedUser.Longitude || storedUser.LocationVersion != utils.LocationDatasetVersion {
+			repo.setUserLocation(&user)
+		}
+
+		if err := tx.Model(&user).Association("Interests").Replace(user.Interests); err != nil {
+			return err
+		}
+
+		// follow counts and the picture fields are only written by their own operations, so a stale user can't
+		// overwrite them.
+		if err := tx.Omit("followers_count", "following_count", "picture_updated_at", "has_picture", "picture_checked_at", "TrainerProfile").Save(&user).Error; err != nil {
+			return err
+		}
+		if err := recordNicknameChange(tx, storedUser, user); err != nil {
+			return err
+		}
+		return recordMeasurement(tx, storedUser, user)
+	})
 	if err != nil {
-		repo.logger.Error("Unable to update user interests", zap.Error(err), zap.Any("user", user))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.User{}, contracts.ErrUserNotFound
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return models.User{}, contracts.ErrUserAlreadyExists
+		}
+		repo.logger.Error("Unable to update user", zap.Error(err), zap.Any("user", user))
 		return models.User{}, err
 	}
-
-	result := db.Save(&user)
-	if result.Error != nil {
-		repo.logger.Error("Unable to update user", zap.Error(result.Error), zap.Any("user", user))
-		return models.User{}, result.Error
-	}
-	repo.fillUserLocation(&user)
 	repo.fillUserPicture(ctx, &user)
 	return user, nil
 }
 
-func (repo UserRepository) FollowUser(ctx context.Context, followedUser models.User, followerUser models.User) error {
+func (repo UserRepository) FollowUser(ctx context.Context, followedUser models.User, followerUser models.User, source string) error {
 	db := repo.db.WithContext(ctx)
 
-	return db.Model(&followedUser).Association("Followers").Append(&followerUser)
+	return db.Transaction(func(tx *gorm.DB) error {
+		follow := models.Follow{UserID: followedUser.ID, FollowerID: followerUser.ID, Source: source}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&follow)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return contracts.ErrAlreadyFollowing
+		}
+		return repo.adjustFollowCounts(tx, followedUser, followerUser, 1)
+	})
 }
 
 func (repo UserRepository) UnfollowUser(ctx context.Context, followedUserID string, followerUserID string) error {
@@ -208,14 +279,315 @@ func (repo UserRepository) UnfollowUser(ctx context.Context, followedUserID stri
 	}
 	db := repo.db.WithContext(ctx)
 
-	return db.Model(&followedUser).Association("Followers").Delete(&followerUser)
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("DELETE FROM user_followers WHERE user_id = ? AND follower_id = ?", followedUser.ID, followerUser.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return contracts.ErrNotFollowing
+		}
+		return repo.adjustFollowCounts(tx, followedUser, followerUser, -1)
+	})
+}
+
+// BatchFollow applies every follow and unfollow in req from followerUser in a single transaction, reporting a result
+// per target. It also returns the users that were newly followed.
+func (repo UserRepository) BatchFollow(ctx context.Context, followerUser models.User, req ucontracts.BatchFollowRequest) (ucontracts.BatchFollowResponse, []models.User, error) {
+	db := repo.db.WithContext(ctx)
+	results := make([]ucontracts.BatchFollowResult, 0, len(req.Follow)+len(req.Unfollow))
+	var followedUsers []models.User
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var targets []models.User
+		result := tx.Where("id IN ?", append(append([]string{}, req.Follow...), req.Unfollow...)).Find(&targets)
+		if result.Error != nil {
+			return result.Error
+		}
+		targetsByID := make(map[string]models.User, len(targets))
+		for _, target := range targets {
+			targetsByID[target.ID] = target
+		}
+
+		for _, userID := range req.Follow {
+			target, exists := targetsByID[userID]
+			status := invalidBatchTargetStatus(exists, userID == followerUser.ID)
+			if status == "" {
+				follow := models.Follow{UserID: target.ID, FollowerID: followerUser.ID, Source: req.Source}
+				result = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&follow)
+				if result.Error != nil {
+					return result.Error
+				}
+				status = ucontracts.BatchFollowStatusAlreadyFollowed
+				if result.RowsAffected > 0 {
+					if err := repo.adjustFollowCounts(tx, target, followerUser, 1); err != nil {
+						return err
+					}
+					status = ucontracts.BatchFollowStatusFollowed
+					followedUsers = append(followedUsers, target)
+				}
+			}
+			results = append(results, ucontracts.BatchFollowResult{UserID: userID, Status: status})
+		}
+
+		for _, userID := range req.Unfollow {
+			target, exists := targetsByID[userID]
+			status := invalidBatchTargetStatus(exists, userID == followerUser.ID)
+			if status == "" {
+				result = tx.Exec("DELETE FROM user_followers WHERE user_id = ? AND follower_id = ?", target.ID, followerUser.ID)
+				if result.Error != nil {
+					return result.Error
+				}
+				status = ucontracts.BatchFollowStatusNotFollowed
+				if result.RowsAffected > 0 {
+					if err := repo.adjustFollowCounts(tx, target, followerUser, -1); err != nil {
+						return err
+					}
+					status = ucontracts.BatchFollowStatusUnfollowed
+				}
+			}
+			results = append(results, ucontracts.BatchFollowResult{UserID: userID, Status: status})
+		}
+		return nil
+	})
+
+	if err != nil {
+		repo.logger.Error("Unable to apply batch follow", zap.Error(err), zap.String("followerID", followerUser.ID), zap.Any("request", req))
+		return ucontracts.BatchFollowResponse{}, nil, err
+	}
+	return ucontracts.BatchFollowResponse{Results: results}, followedUsers, nil
+}
+
+// SetPictureUpdatedAt records a picture uploaded through the service, which also marks the user as having one.
+func (repo UserRepository) SetPictureUpdatedAt(ctx context.Context, userID string, updatedAt time.Time) error {
+	db := repo.db.WithContext(ctx)
+
+	result := db.Model(&models.User{}).Where("id = ?", userID).UpdateColumns(map[string]interface{}{
+		"picture_updated_at": updatedAt,
+		"has_picture":        true,
+	})
+	if result.Error != nil {
+		repo.logger.Error("Unable to update user picture date", zap.Error(result.Error), zap.String("ID", userID))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return contracts.ErrUserNotFound
+	}
+	return nil
+}
+
+// SetDisabled updates the disabled flag of a user. Follow counts only include enabled users, so the counts of
+// everyone the user follows or is followed by are adjusted in the same transaction.
+func (repo UserRepository) SetDisabled(ctx context.Context, userID string, disabled bool) error {
+	db := repo.db.WithContext(ctx)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.User{}).Where("id = ? AND disabled = ?", userID, !disabled).UpdateColumn("disabled", disabled)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		delta := 1
+		if disabled {
+			delta = -1
+		}
+		return repo.adjustCounterpartFollowCounts(tx, userID, delta)
+	})
+
+	if err != nil {
+		repo.logger.Error("Unable to update user disabled status", zap.Error(err), zap.String("ID", userID), zap.Bool("disabled", disabled))
+		return err
+	}
+	return nil
+}
+
+// SetNickname renames a user without recording the previous nickname in the nickname history, so it's neither held
+// nor redirects to the user. Meant for moderation, where the previous nickname shouldn't keep pointing to the user.
+func (repo UserRepository) SetNickname(ctx context.Context, userID string, nickname string) error {
+	db := repo.db.WithContext(ctx)
+
+	result := db.Model(&models.User{}).Where("id = ?", userID).UpdateColumn("nickname", nickname)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return contracts.ErrUserAlreadyExists
+		}
+		repo.logger.Error("Unable to update user nickname", zap.Error(result.Error), zap.String("ID", userID))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return contracts.ErrUserNotFound
+	}
+	return nil
+}
+
+// ReconcileFollowCounts recomputes every user's follow counts from user_followers, returning how many users had
+// drifted.
+func (repo UserRepository) ReconcileFollowCounts(ctx context.Context) (int64, error) {
+	db := repo.db.WithContext(ctx)
+
+	result := db.Exec(`
+		UPDATE users SET followers_count = counts.followers, following_count = counts.following
+		FROM (
+			SELECT u.id,
+				(SELECT COUNT(*) FROM user_followers f JOIN users other ON other.id = f.follower_id
+					WHERE f.user_id = u.id AND NOT other.disabled AND other.deleted_at IS NULL) AS followers,
+				(SELECT COUNT(*) FROM user_followers f JOIN users other ON other.id = f.user_id
+					WHERE f.follower_id = u.id AND NOT other.disabled AND other.deleted_at IS NULL) AS following
+			FROM users u
+		) counts
+		WHERE users.id = counts.id AND (users.followers_count <> counts.followers OR users.following_count <> counts.following)`)
+
+	if result.Error != nil {
+		repo.logger.Error("Unable to reconcile follow counts", zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// recordMeasurement stores the body data of updatedUser as a new measurement if it differs from previousUser's.
+func recordMeasurement(tx *gorm.DB, previousUser models.User, updatedUser models.User) error {
+	if updatedUser.Height == previousUser.Height && updatedUser.Weight == previousUser.Weight {
+		return nil
+	}
+
+	measurement := models.Measurement{
+		UserID:     updatedUser.ID,
+		Height:     updatedUser.Height,
+		Weight:     updatedUser.Weight,
+		MeasuredAt: time.Now(),
+	}
+	return tx.Create(&measurement).Error
+}
+
+// recordNicknameChange stores previousUser's nickname in the nickname history if updatedUser changed it. Changes
+// that only affect case are not recorded, as the nickname is still taken.
+func recordNicknameChange(tx *gorm.DB, previousUser models.User, updatedUser models.User) error {
+	if strings.EqualFold(updatedUser.Nickname, previousUser.Nickname) {
+		return nil
+	}
+
+	change := models.NicknameChange{
+		UserID:    updatedUser.ID,
+		Nickname:  previousUser.Nickname,
+		ChangedAt: time.Now(),
+	}
+	return tx.Create(&change).Error
+}
+
+// filterFollowsByDate keeps the user_followers rows created within [from, to]. Either bound may be nil.
+func filterFollowsByDate(db *gorm.DB, from *time.Time, to *time.Time) *gorm.DB {
+	if from != nil {
+		db = db.Where("user_followers.created_at >= ?", *from)
+	}
+	if to != nil {
+		db = db.Where("user_followers.created_at <= ?", *to)
+	}
+	return db
+}
+
+func followOrder(order string) string {
+	if order == ucontracts.FollowOrderOldest {
+		return "user_followers.created_at ASC"
+	}
+	return "user_followers.created_at DESC"
+}
+
+// invalidBatchTargetStatus returns the status of a batch follow target that can't be applied, or "" if it can.
+func invalidBatchTargetStatus(exists bool, isSelf bool) string {
+	if isSelf {
+		return ucontracts.BatchFollowStatusSelf
+	}
+	if !exists {
+		return ucontracts.BatchFollowStatusNotFound
+	}
+	return ""
+}
+
+// BackfillLocations stores the location of up to batchSize users after afterID, in ID order, whose location is
+// missing or was resolved with an older dataset. It returns how many users were updated and the ID of the last user
+// read, which is empty once no users are left. Users whose coordinates can't be resolved are left outdated, so they are
+// tried again on the next backfill.
+func (repo UserRepository) BackfillLocations(ctx context.Context, afterID string, batchSize int) (int, string, error) {
+	db := repo.db.WithContext(ctx)
+
+	var outdated []models.User
+	result := db.Select("id", "latitude", "longitude").
+		Where("location_version <> ? AND id > ?", utils.LocationDatasetVersion, afterID).
+		Order("id").Limit(batchSize).Find(&outdated)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get users with outdated locations", zap.Error(result.Error))
+		return 0, "", result.Error
+	}
+	if len(outdated) == 0 {
+		return 0, "", nil
+	}
+
+	updated := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for i := range outdated {
+			user := outdated[i]
+			repo.setUserLocation(&user)
+			if user.LocationVersion != utils.LocationDatasetVersion {
+				continue
+			}
+			// users that moved since they were read had their location stored by Update already
+			result := tx.Model(&models.User{}).
+				Where("id = ? AND location_version <> ? AND latitude = ? AND longitude = ?", user.ID, utils.LocationDatasetVersion, user.Latitude, user.Longitude).
+				UpdateColumns(map[string]interface{}{
+					"main_location":    user.MainLocation,
+					"location_version": user.LocationVersion,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			updated += int(result.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		repo.logger.Error("Unable to backfill user locations", zap.Error(err))
+		return 0, "", err
+	}
+	return updated, outdated[len(outdated)-1].ID, nil
+}
+
+// adjustFollowCounts applies delta to the counts affected by a single follow edge. Edges with a disabled user on
+// the other end are not counted.
+func (repo UserRepository) adjustFollowCounts(tx *gorm.DB, followedUser models.User, followerUser models.User, delta int) error {
+	if !followerUser.Disabled {
+		result := tx.Model(&models.User{}).Where("id = ?", followedUser.ID).UpdateColumn("followers_count", gorm.Expr("followers_count + ?", delta))
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	if !followedUser.Disabled {
+		result := tx.Model(&models.User{}).Where("id = ?", followerUser.ID).UpdateColumn("following_count", gorm.Expr("following_count + ?", delta))
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+// adjustCounterpartFollowCounts applies delta to the counts of every user on the other end of userID's follow edges.
+func (repo UserRepository) adjustCounterpartFollowCounts(tx *gorm.DB, userID string, delta int) error {
+	result := tx.Exec("UPDATE users SET followers_count = followers_count + ? WHERE id IN (SELECT user_id FROM user_followers WHERE follower_id = ?)", delta, userID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = tx.Exec("UPDATE users SET following_count = following_count + ? WHERE id IN (SELECT follower_id FROM user_followers WHERE user_id = ?)", delta, userID)
+	return result.Error
 }
 
 func (repo UserRepository) GetFollowers(ctx context.Context, req ucontracts.GetUserFollowersRequest) (ucontracts.GetUserFollowersResponse, error) {
 	db := repo.db.WithContext(ctx)
 	var user models.User
-	result := db.Preload("Followers", database.Paginate(&user.Followers, &req.Pagination, db)).First(&user, "users.id = ?", req.UserID)
-
+	result := db.Select("id").First(&user, "id = ?", req.UserID)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return ucontracts.GetUserFollowersResponse{}, contracts.ErrUserNotFound
@@ -225,19 +597,20 @@ func (repo UserRepository) GetFollowers(ctx context.Context, req ucontracts.GetU
 		return ucontracts.GetUserFollowersResponse{}, result.Error
 	}
 
-	// TODO: figure out how to do this properly inside database.Paginate(). We have to overwrite the totalRows with
-	// the following count(), because otherwise the total user count is set. Maybe use db.Scopes() ?
-
-	req.Pagination.TotalRows = db.Model(&user).Association("Followers").Count()
-
-	for i := range user.Followers {
-		repo.fillUserLocation(&user.Followers[i])
-		repo.fillUserPicture(ctx, &user.Followers[i])
+	var followers []models.User
+	db = db.Model(&followers).Joins("JOIN user_followers ON user_followers.follower_id = users.id").Where("user_followers.user_id = ?", req.UserID)
+	db = filterFollowsByDate(db, req.From, req.To)
+	result = db.Scopes(database.Paginate(followers, &req.Pagination, db)).Select("users.*, user_followers.created_at AS followed_at").Order(followOrder(req.Order)).Find(&followers)
+	if result.Error != nil {
+		repo.logger.Error("unable to get user followers", zap.Error(result.Error), zap.String("userID", req.UserID))
+		return ucontracts.GetUserFollowersResponse{}, result.Error
 	}
 
+	repo.fillUserPictures(ctx, followers)
+
 	response := ucontracts.GetUserFollowersResponse{
 		Pagination: req.Pagination,
-		Followers:  user.Followers,
+		Followers:  followers,
 	}
 
 	return response, nil
@@ -248,7 +621,8 @@ func (repo UserRepository) GetFollowed(ctx context.Context, req ucontracts.GetFo
 	var followedUsers []models.User
 
 	db = db.Model(&followedUsers).Joins("LEFT JOIN user_followers ON user_followers.user_id = users.id").Where("user_followers.follower_id = ?", req.UserID)
-	result := db.Scopes(database.Paginate(followedUsers, &req.Pagination, db)).Find(&followedUsers)
+	db = filterFollowsByDate(db, req.From, req.To)
+	result := db.Scopes(database.Paginate(followedUsers, &req.Pagination, db)).Select("users.*, user_followers.created_at AS followed_at").Order(followOrder(req.Order)).Find(&followedUsers)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -259,10 +633,7 @@ func (repo UserRepository) GetFollowed(ctx context.Context, req ucontracts.GetFo
 		return ucontracts.GetFollowedUsersResponse{}, result.Error
 	}
 
-	for i := range followedUsers {
-		repo.fillUserLocation(&followedUsers[i])
-		repo.fillUserPicture(ctx, &followedUsers[i])
-	}
+	repo.fillUserPictures(ctx, followedUsers)
 
 	response := ucontracts.GetFollowedUsersResponse{
 		Pagination: req.Pagination,
@@ -272,16 +643,346 @@ func (repo UserRepository) GetFollowed(ctx context.Context, req ucontracts.GetFo
 	return response, nil
 }
 
-func (repo UserRepository) fillUserLocation(user *models.User) {
+func (repo UserRepository) GetRelationships(ctx context.Context, userID string, otherUserIDs []string) ([]ucontracts.Relationship, error) {
+	db := repo.db.WithContext(ctx)
+	var relationships []ucontracts.Relationship
+
+	result := db.Raw(`
+		SELECT u.id AS user_id,
+			EXISTS (SELECT 1 FROM user_followers f WHERE f.user_id = u.id AND f.follower_id = @user_id) AS follows,
+			EXISTS (SELECT 1 FROM user_followers f WHERE f.user_id = @user_id AND f.follower_id = u.id) AS followed_by,
+			u.disabled AS blocked
+		FROM users u
+		WHERE u.id IN @other_user_ids AND u.deleted_at IS NULL`,
+		map[string]interface{}{"user_id": userID, "other_user_ids": otherUserIDs},
+	).Scan(&relationships)
+
+	if result.Error != nil {
+		repo.logger.Error("Unable to get user relationships", zap.Error(result.Error), zap.String("userID", userID), zap.Strings("otherUserIDs", otherUserIDs))
+		return nil, result.Error
+	}
+
+	for i := range relationships {
+		relationships[i].Mutual = relationships[i].Follows && relationships[i].FollowedBy
+	}
+	return relationships, nil
+}
+
+func (repo UserRepository) GetMutuals(ctx context.Context, req ucontracts.GetMutualsRequest) (ucontracts.GetMutualsResponse, error) {
+	db := repo.db.WithContext(ctx)
+	var mutuals []models.User
+
+	db = db.Model(&mutuals).
+		Joins("JOIN user_followers followed ON followed.user_id = users.id AND followed.follower_id = ?", req.UserID).
+		Joins("JOIN user_followers following ON following.user_id = ? AND following.follower_id = users.id", req.UserID).
+		Where("NOT users.disabled")
+	result := db.Scopes(database.Paginate(mutuals, &req.Pagination, db)).Preload("Interests.Translations").Find(&mutuals)
+
+	if result.Error != nil {
+		repo.logger.Error("unable to get user mutuals", zap.Error(result.Error), zap.String("userID", req.UserID))
+		return ucontracts.GetMutualsResponse{}, result.Error
+	}
+
+	repo.fillUserPictures(ctx, mutuals)
+
+	return ucontracts.GetMutualsResponse{Pagination: req.Pagination, Mutuals: mutuals}, nil
+}
+
+// Weights used to rank recommended users. Proximity decays linearly until the requested max distance.
+const (
+	sharedInterestWeight = 3.0
+	proximityWeight      = 5.0
+	mutualFollowerWeight = 2.0
+	trainerWeight        = 4.0
+)
+
+// recommendationsQuery scores every enabled user the requesting user doesn't follow yet.
+const recommendationsQuery = `
+SELECT scored.*,
+	scored.interests_score + scored.proximity_score + scored.mutual_followers_score + scored.trainer_score AS total
+FROM (
+	SELECT candidates.*,
+		candidates.shared_interests * @interest_weight AS interests_score,
+		GREATEST(0, 1 - candidates.distance_km / @max_distance) * @proximity_weight AS proximity_score,
+		candidates.mutual_followers * @mutual_weight AS mutual_followers_score,
+		CASE WHEN candidates.is_verified_trainer THEN @trainer_weight ELSE 0 END AS trainer_score
+	FROM (
+		SELECT u.id AS user_id,
+			u.is_verified_trainer,
+			(SELECT COUNT(*) FROM user_interests ui
+				JOIN user_interests mine ON mine.interest_name = ui.interest_name AND mine.user_id = me.id
+				WHERE ui.user_id = u.id) AS shared_interests,
+			earth_distance(ll_to_earth(me.latitude, me.longitude), ll_to_earth(u.latitude, u.longitude)) / 1000 AS distance_km,
+			(SELECT COUNT(*) FROM user_followers f
+				JOIN user_followers mine ON mine.user_id = f.follower_id AND mine.follower_id = me.id
+				WHERE f.user_id = u.id) AS mutual_followers
+		FROM users u, users me
+		WHERE me.id = @user_id AND u.id <> me.id AND NOT u.disabled AND u.deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM user_followers f WHERE f.user_id = u.id AND f.follower_id = me.id)
+	) candidates
+) scored`
+
+type recommendationRow struct {
+	UserID string
+	ucontracts.RecommendationScore
+}
+
+func (repo UserRepository) GetRecommendations(ctx context.Context, req ucontracts.GetUserRecommendationsRequest) (ucontracts.GetUserRecommendationsResponse, error) {
+	db := repo.db.WithContext(ctx)
+
+	maxDistance := req.MaxDistance
+	if maxDistance == 0 {
+		maxDistance = ucontracts.DefaultRecommendationDistance
+	}
+
+	scored := db.Raw(recommendationsQuery, map[string]interface{}{
+		"user_id":          req.UserID,
+		"max_distance":     float64(maxDistance),
+		"interest_weight":  sharedInterestWeight,
+		"proximity_weight": proximityWeight,
+		"mutual_weight":    mutualFollowerWeight,
+		"trainer_weight":   trainerWeight,
+	})
+	query := db.Table("(?) AS recommendations", scored).Where("total > 0").Session(&gorm.Session{})
+
+	result := query.Count(&req.Pagination.TotalRows)
+	if result.Error != nil {
+		repo.logger.Error("Unable to count user recommendations", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetUserRecommendationsResponse{}, result.Error
+	}
+
+	var rows []recommendationRow
+	result = query.Order("total DESC").Order("user_id").Offset(req.Pagination.ToOffset()).Limit(req.Pagination.ToLimit()).Scan(&rows)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get user recommendations", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetUserRecommendationsResponse{}, result.Error
+	}
+
+	userIDs := make([]string, len(rows))
+	for i, row := range rows {
+		userIDs[i] = row.UserID
+	}
+
+	var recommendedUsers []models.User
+	result = db.Where("id IN ?", userIDs).Preload("Interests.Translations").Find(&recommendedUsers)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get recommended users", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetUserRecommendationsResponse{}, result.Error
+	}
+
+	repo.fillUserPictures(ctx, recommendedUsers)
+	usersByID := make(map[string]models.User, len(recommendedUsers))
+	for _, user := range recommendedUsers {
+		usersByID[user.ID] = user
+	}
+
+	recommendations := make([]ucontracts.UserRecommendation, 0, len(rows))
+	for _, row := range rows {
+		user, ok := usersByID[row.UserID]
+		if !ok {
+			continue
+		}
+		recommendations = append(recommendations, ucontracts.UserRecommendation{User: user, Score: row.RecommendationScore})
+	}
+
+	return ucontracts.GetUserRecommendationsResponse{Recommendations: recommendations, Pagination: req.Pagination}, nil
+}
+
+// followSuggestionsQuery groups the users followed by the people the requesting user follows.
+const followSuggestionsQuery = `
+SELECT f.user_id AS user_id,
+	COUNT(*) AS mutual_count,
+	(ARRAY_AGG(f.follower_id ORDER BY f.follower_id))[1:@preview_size] AS mutual_preview_ids
+FROM user_followers f
+JOIN user_followers mine ON mine.user_id = f.follower_id AND mine.follower_id = @user_id
+JOIN users u ON u.id = f.user_id AND NOT u.disabled AND u.deleted_at IS NULL
+WHERE f.user_id <> @user_id
+	AND NOT EXISTS (SELECT 1 FROM user_followers already WHERE already.user_id = f.user_id AND already.follower_id = @user_id)
+GROUP BY f.user_id`
+
+type followSuggestionRow struct {
+	UserID           string
+	MutualCount      int64
+	MutualPreviewIDs pq.StringArray `gorm:"column:mutual_preview_ids"`
+}
+
+func (repo UserRepository) GetFollowSuggestions(ctx context.Context, req ucontracts.GetFollowSuggestionsRequest) (ucontracts.GetFollowSuggestionsResponse, error) {
+	db := repo.db.WithContext(ctx)
+
+	grouped := db.Raw(followSuggestionsQuery, map[string]interface{}{
+		"user_id":      req.UserID,
+		"preview_size": ucontracts.FollowSuggestionPreviewSize,
+	})
+	query := db.Table("(?) AS suggestions", grouped).Session(&gorm.Session{})
+
+	result := query.Count(&req.Pagination.TotalRows)
+	if result.Error != nil {
+		repo.logger.Error("Unable to count follow suggestions", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetFollowSuggestionsResponse{}, result.Error
+	}
+
+	var rows []followSuggestionRow
+	result = query.Order("mutual_count DESC").Order("user_id").Offset(req.Pagination.ToOffset()).Limit(req.Pagination.ToLimit()).Scan(&rows)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get follow suggestions", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetFollowSuggestionsResponse{}, result.Error
+	}
+
+	var userIDs []string
+	for _, row := range rows {
+		userIDs = append(userIDs, row.UserID)
+		userIDs = append(userIDs, row.MutualPreviewIDs...)
+	}
+
+	var relatedUsers []models.User
+	result = db.Where("id IN ?", userIDs).Preload("Interests.Translations").Find(&relatedUsers)
+	if result.Error != nil {
+		repo.logger.Error("Unable to get follow suggestion users", zap.Error(result.Error), zap.Any("request", req))
+		return ucontracts.GetFollowSuggestionsResponse{}, result.Error
+	}
+
+	repo.fillUserPictures(ctx, relatedUsers)
+	usersByID := make(map[string]models.User, len(relatedUsers))
+	for _, user := range relatedUsers {
+		usersByID[user.ID] = user
+	}
+
+	suggestions := make([]ucontracts.FollowSuggestion, 0, len(rows))
+	for _, row := range rows {
+		user, ok := usersByID[row.UserID]
+		if !ok {
+			continue
+		}
+
+		previews := make([]ucontracts.UserPreview, 0, len(row.MutualPreviewIDs))
+		for _, mutualID := range row.MutualPreviewIDs {
+			if mutual, ok := usersByID[mutualID]; ok {
+				previews = append(previews, ucontracts.UserPreview{
+					ID:          mutual.ID,
+					Nickname:    mutual.Nickname,
+					DisplayName: mutual.DisplayName,
+					PictureUrl:  mutual.PictureUrl,
+				})
+			}
+		}
+
+		suggestions = append(suggestions, ucontracts.FollowSuggestion{User: user, MutualCount: row.MutualCount, MutualPreviews: previews})
+	}
+
+	return ucontracts.GetFollowSuggestionsResponse{Suggestions: suggestions, Pagination: req.Pagination}, nil
+}
+
+// fillTrainerProfile completes the profile of verified trainers and hides it from everyone else, e.g. trainers whose
+// verification was revoked.
+func (repo UserRepository) fillTrainerProfile(db *gorm.DB, user *models.User) error {
+	if !user.IsVerifiedTrainer || user.TrainerProfile == nil {
+		user.TrainerProfile = nil
+		return nil
+	}
+
+	if err := fillTrainerProfileDetails(db, user.TrainerProfile); err != nil {
+		repo.logger.Error("Unable to get trainer profile details", zap.Error(err), zap.String("ID", user.ID))
+		return err
+	}
+	return nil
+}
+
+// filterTrainers keeps the verified trainers whose profile matches the trainer filters of req.
+func filterTrainers(db *gorm.DB, req ucontracts.GetUsersRequest) *gorm.DB {
+	db = db.Where("is_verified_trainer = ?", true)
+	profiles := db.Session(&gorm.Session{NewDB: true}).Table("trainer_profiles").Select("1").Where("trainer_profiles.user_id = users.id")
+
+	if req.Specialty != "" {
+		profiles = profiles.Where("EXISTS (SELECT 1 FROM trainer_specialties WHERE trainer_specialties.trainer_profile_user_id = trainer_profiles.user_id AND trainer_specialties.interest_name = ?)", req.Specialty)
+	}
+	if req.MinExperience != nil {
+		profiles = profiles.Where("trainer_profiles.years_of_experience >= ?", *req.MinExperience)
+	}
+	if req.TrainerLanguage != "" {
+		profiles = profiles.Where("? = ANY(trainer_profiles.languages)", req.TrainerLanguage)
+	}
+	return db.Where("EXISTS (?)", profiles)
+}
+
+// setUserLocation resolves the location of the user's coordinates, which is stored along with the dataset version
+// it was resolved with.
+func (repo UserRepository) setUserLocation(user *models.User) {
 	usrLocation, err := repo.reverseLocator.GetLocationFromCoordinates(user.Latitude, user.Longitude)
 	if err != nil {
-		repo.logger.Error("Unable to reverse geolocate user's coordinates")
+		repo.logger.Error("Unable to reverse geolocate user's coordinates", zap.String("ID", user.ID))
+		// unresolved locations are left for BackfillLocations to retry
+		user.MainLocation = ""
+		user.LocationVersion = 0
 		return
 	}
 	user.MainLocation = usrLocation
+	user.LocationVersion = utils.LocationDatasetVersion
+}
+
+// pictureFillConcurrency bounds the storage calls made at once while filling a page of users.
+const pictureFillConcurrency = 8
+
+// fillUserPictures fills the pictures of a page of users concurrently, since each one may need storage calls.
+func (repo UserRepository) fillUserPictures(ctx context.Context, users []models.User) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, pictureFillConcurrency)
+	for i := range users {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(user *models.User) {
+			defer wg.Done()
+			repo.fillUserPicture(ctx, user)
+			<-sem
+		}(&users[i])
+	}
+	wg.Wait()
 }
 
+// legacyPictureRecheckInterval is how long a missing picture is trusted before storage is looked up again, as
+// clients may still upload pictures straight to it.
+const legacyPictureRecheckInterval = time.Hour
+
+// fillUserPicture sets the URLs of every picture size for pictures uploaded through the service. Users that never
+// uploaded one through the service get their storage looked up for a picture uploaded straight to it. The result is
+// recorded, missing pictures being looked up again after legacyPictureRecheckInterval.
 func (repo UserRepository) fillUserPicture(ctx context.Context, user *models.User) {
-	userPictureUrl := repo.auth.GetUserPictureUrl(ctx, user.ID)
-	(*user).PictureUrl = userPictureUrl
+	now := time.Now()
+	if needsLegacyPictureCheck(*user, now) {
+		hasPicture, err := repo.firebase.HasLegacyPicture(ctx, user.ID)
+		if err == nil {
+			repo.setHasPicture(ctx, user.ID, hasPicture, now)
+		}
+		user.HasPicture = &hasPicture
+	}
+
+	if user.PictureUpdatedAt == nil {
+		user.PictureUrl = repo.firebase.GetUserPictureUrl(ctx, user.ID, *user.HasPicture)
+		return
+	}
+
+	user.PictureUrls = repo.firebase.GetUserPictureUrls(ctx, user.ID, *user.PictureUpdatedAt)
+	user.PictureUrl = user.PictureUrls[models.PictureSizeOriginal]
+}
+
+func (repo UserRepository) setHasPicture(ctx context.Context, userID string, hasPicture bool, checkedAt time.Time) {
+	db := repo.db.WithContext(ctx)
+	// pictures uploaded through the service meanwhile are kept
+	result := db.Model(&models.User{}).Where("id = ? AND has_picture IS NOT TRUE", userID).UpdateColumns(map[string]interface{}{
+		"has_picture":        hasPicture,
+		"picture_checked_at": checkedAt,
+	})
+	if result.Error != nil {
+		repo.logger.Error("Unable to record user picture", zap.Error(result.Error), zap.String("ID", userID))
+	}
+}
+
+// needsLegacyPictureCheck reports whether user's storage must be looked up for a picture uploaded straight to it.
+func needsLegacyPictureCheck(user models.User, now time.Time) bool {
+	if user.HasPicture == nil {
+		return true
+	}
+	if *user.HasPicture {
+		return false
+	}
+	return user.PictureCheckedAt == nil || now.Sub(*user.PictureCheckedAt) >= legacyPictureRecheckInterval
 }
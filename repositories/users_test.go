@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/users"
@@ -11,6 +12,7 @@ import (
 	"github.com/fiufit/users/repositories/mocks"
 	"github.com/fiufit/users/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap/zaptest"
 	"gorm.io/gorm"
 )
@@ -22,8 +24,9 @@ func TestUserRepository_CreateUser_DBError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	_ = repo.db.AddError(errors.New("test error"))
 
@@ -39,8 +42,9 @@ func TestUserRepository_CreateUser_DuplicatedError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	db.Create(&testUser)
 
@@ -56,8 +60,9 @@ func TestUserRepository_CreateUser_Ok(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	_, err := repo.CreateUser(ctx, testUser)
 
@@ -74,8 +79,9 @@ func TestUserRepository_GetByID_DBError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 	_ = db.AddError(errors.New("test error"))
 
 	_, err := repo.GetByID(ctx, testUser.ID)
@@ -91,8 +97,9 @@ func TestUserRepository_GetByID_NotFoundError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	_, err := repo.GetByID(ctx, testUser.ID)
 	assert.Error(t, err)
@@ -106,8 +113,9 @@ func TestUserRepository_GetByID_OK(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	db.Create(&testUser)
 
@@ -123,8 +131,9 @@ func TestUserRepository_GetByNickname_DBError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test", Nickname: "Arnold"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 	_ = db.AddError(errors.New("test error"))
 
 	_, err := repo.GetByNickname(ctx, testUser.Nickname)
@@ -140,8 +149,9 @@ func TestUserRepository_GetByNickname_NotFoundError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test", Nickname: "Arnold"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	_, err := repo.GetByNickname(ctx, testUser.Nickname)
 	assert.Error(t, err)
@@ -155,8 +165,9 @@ func TestUserRepository_GetByNickname_OK(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test", Nickname: "Arnold"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	db.Create(&testUser)
 
@@ -165,6 +176,27 @@ func TestUserRepository_GetByNickname_OK(t *testing.T) {
 	assert.Equal(t, resultUser.ID, testUser.ID)
 }
 
+func TestUserRepository_GetByNickname_IgnoresCase(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	testUser := models.User{ID: "test", Nickname: "Arnold"}
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, mock.Anything, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	db.Create(&testUser)
+
+	resultUser, err := repo.GetByNickname(ctx, "aRNOLD")
+	assert.NoError(t, err)
+	assert.Equal(t, resultUser.ID, testUser.ID)
+
+	_, err = repo.CreateUser(ctx, models.User{ID: "other", Nickname: "ARNOLD"})
+	assert.ErrorIs(t, err, contracts.ErrUserAlreadyExists)
+}
+
 func TestUserRepository_Update_DBError(t *testing.T) {
 	defer testSuite.TruncateModels()
 	ctx := context.Background()
@@ -172,8 +204,9 @@ func TestUserRepository_Update_DBError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test", Nickname: "Arnold"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 	_ = db.AddError(errors.New("test error"))
 
 	_, err := repo.Update(ctx, testUser)
@@ -189,8 +222,9 @@ func TestUserRepository_Update_OK(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "test", Nickname: "Arnold"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	db.Create(&testUser)
 	patchedUser := models.User{ID: testUser.ID, Nickname: "Arnold2"}
@@ -208,8 +242,9 @@ func TestUserRepository_DeleteUser_DBError(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "testUserID"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	_ = db.Create(&testUser)
 	_ = db.AddError(errors.New("test error"))
@@ -225,17 +260,19 @@ func TestUserRepository_DeleteUser_DBError(t *testing.T) {
 	assert.Equal(t, existingUser.ID, testUser.ID)
 }
 
-func TestUserRepository_DeleteUser_FirebaseError(t *testing.T) {
+func TestUserRepository_DeleteUser_IdentityProviderError(t *testing.T) {
 	defer testSuite.TruncateModels()
 	ctx := context.Background()
 	db := testSuite.DB
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "testUserID"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	identityMock := new(mocks.IdentityProvider)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, identityMock, reverseLocator)
 
-	firebaseMock.On("DeleteUser", ctx, testUser.ID).Return(errors.New("test error"))
+	identityMock.On("DeleteUser", ctx, testUser.ID).Return(errors.New("test error"))
 	_ = db.Create(&testUser)
 	err := repo.DeleteUser(ctx, testUser.ID)
 
@@ -256,11 +293,13 @@ func TestUserRepository_DeleteUser_OK(t *testing.T) {
 	reverseLocator, _ := utils.NewReverseLocator()
 	testUser := models.User{ID: "testID"}
 	firebaseMock := new(mocks.Firebase)
-	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID).Return("")
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, mock.Anything).Return("")
+	identityMock := new(mocks.IdentityProvider)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, identityMock, reverseLocator)
 
 	_ = db.Create(&testUser)
-	firebaseMock.On("DeleteUser", ctx, testUser.ID).Return(nil)
+	identityMock.On("DeleteUser", ctx, testUser.ID).Return(nil)
 	err := repo.DeleteUser(ctx, testUser.ID)
 
 	assert.NoError(t, err)
@@ -276,7 +315,7 @@ func TestUserRepository_Get_DBError(t *testing.T) {
 	db := testSuite.DB
 	reverseLocator, _ := utils.NewReverseLocator()
 	firebaseMock := new(mocks.Firebase)
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	_ = db.AddError(errors.New("test error"))
 	testReq := users.GetUsersRequest{}
@@ -293,7 +332,7 @@ func TestUserRepository_Get_OK(t *testing.T) {
 	db := testSuite.DB
 	reverseLocator, _ := utils.NewReverseLocator()
 	firebaseMock := new(mocks.Firebase)
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	testUsers := [7]models.User{
 		{ID: "a", Nickname: "Guille"},
@@ -306,7 +345,8 @@ func TestUserRepository_Get_OK(t *testing.T) {
 	}
 
 	for _, user := range testUsers {
-		firebaseMock.On("GetUserPictureUrl", ctx, user.ID).Return("")
+		firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+		firebaseMock.On("GetUserPictureUrl", ctx, user.ID, mock.Anything).Return("")
 	}
 
 	_ = db.Create(&testUsers)
@@ -373,7 +413,7 @@ func TestUserRepository_GetByDistance_DBError(t *testing.T) {
 	db := testSuite.DB
 	reverseLocator, _ := utils.NewReverseLocator()
 	firebaseMock := new(mocks.Firebase)
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	_ = db.AddError(errors.New("test error"))
 	testReq := users.GetClosestUsersRequest{}
@@ -391,7 +431,7 @@ func TestUserRepository_GetByDistance_Ok(t *testing.T) {
 	db := testSuite.DB
 	reverseLocator, _ := utils.NewReverseLocator()
 	firebaseMock := new(mocks.Firebase)
-	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, reverseLocator)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
 
 	testUsers := [3]models.User{
 		{ID: "a", Nickname: "Guille", Latitude: -34.6, Longitude: -58.38},
@@ -400,7 +440,8 @@ func TestUserRepository_GetByDistance_Ok(t *testing.T) {
 	}
 
 	for _, user := range testUsers {
-		firebaseMock.On("GetUserPictureUrl", ctx, user.ID).Return("")
+		firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+		firebaseMock.On("GetUserPictureUrl", ctx, user.ID, mock.Anything).Return("")
 	}
 
 	_ = db.Create(&testUsers)
@@ -410,3 +451,429 @@ func TestUserRepository_GetByDistance_Ok(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, len(res.Users), 1)
 }
+
+func TestUserRepository_GetRecommendations_DBError(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	_ = db.AddError(errors.New("test error"))
+	_, err := repo.GetRecommendations(ctx, users.GetUserRecommendationsRequest{UserID: "a"})
+
+	assert.Error(t, err)
+	db.Error = nil //overwrite the db Error so that TruncateModels() doesn't panic
+}
+
+func TestUserRepository_GetRecommendations_Ok(t *testing.T) {
+	t.Skip("TODO: figure out how to enable EARTHDISTANCE postgres extension in testsuite postgres container")
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	strength := models.Interest{Name: "strength", Active: true}
+	testUsers := []models.User{
+		{ID: "a", Nickname: "a", Latitude: -34.6, Longitude: -58.38, Interests: []models.Interest{strength}},
+		{ID: "b", Nickname: "b", Latitude: -34.6, Longitude: -58.38, Interests: []models.Interest{strength}},
+		{ID: "c", Nickname: "c", Latitude: -34.6, Longitude: -58.38, Interests: []models.Interest{strength}},
+		{ID: "d", Nickname: "d", Latitude: -34.6, Longitude: -58.38, Disabled: true},
+	}
+	for _, user := range testUsers {
+		firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+		firebaseMock.On("GetUserPictureUrl", ctx, user.ID, mock.Anything).Return("")
+	}
+	_ = db.Create(&testUsers)
+	_ = db.Model(&testUsers[2]).Association("Followers").Append(&testUsers[0])
+
+	res, err := repo.GetRecommendations(ctx, users.GetUserRecommendationsRequest{UserID: "a"})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Recommendations, 1)
+	assert.Equal(t, "b", res.Recommendations[0].User.ID)
+	assert.Equal(t, int64(1), res.Recommendations[0].Score.SharedInterests)
+}
+
+func TestUserRepository_GetFollowSuggestions_DBError(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	_ = db.AddError(errors.New("test error"))
+	_, err := repo.GetFollowSuggestions(ctx, users.GetFollowSuggestionsRequest{UserID: "a"})
+
+	assert.Error(t, err)
+	db.Error = nil //overwrite the db Error so that TruncateModels() doesn't panic
+}
+
+func TestUserRepository_GetFollowSuggestions_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{{ID: "a", Nickname: "a"}, {ID: "b", Nickname: "b"}, {ID: "c", Nickname: "c"}, {ID: "d", Nickname: "d"}, {ID: "e", Nickname: "e"}}
+	for _, user := range testUsers {
+		firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+		firebaseMock.On("GetUserPictureUrl", ctx, user.ID, mock.Anything).Return("")
+	}
+	_ = db.Create(&testUsers)
+
+	// a follows b and c, both of them follow d and c also follows e
+	_ = db.Model(&testUsers[1]).Association("Followers").Append(&testUsers[0])
+	_ = db.Model(&testUsers[2]).Association("Followers").Append(&testUsers[0])
+	_ = db.Model(&testUsers[3]).Association("Followers").Append(&testUsers[1], &testUsers[2])
+	_ = db.Model(&testUsers[4]).Association("Followers").Append(&testUsers[2])
+
+	res, err := repo.GetFollowSuggestions(ctx, users.GetFollowSuggestionsRequest{UserID: "a"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), res.Pagination.TotalRows)
+	assert.Equal(t, "d", res.Suggestions[0].User.ID)
+	assert.Equal(t, int64(2), res.Suggestions[0].MutualCount)
+	assert.Len(t, res.Suggestions[0].MutualPreviews, 2)
+	assert.Equal(t, "e", res.Suggestions[1].User.ID)
+}
+
+func TestUserRepository_GetRelationships_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{{ID: "a", Nickname: "a"}, {ID: "b", Nickname: "b"}, {ID: "c", Nickname: "c"}, {ID: "d", Nickname: "d", Disabled: true}}
+	_ = db.Create(&testUsers)
+	_ = db.Model(&testUsers[1]).Association("Followers").Append(&testUsers[0])
+	_ = db.Model(&testUsers[0]).Association("Followers").Append(&testUsers[1], &testUsers[2])
+
+	relationships, err := repo.GetRelationships(ctx, "a", []string{"b", "c", "d", "unknown"})
+	assert.NoError(t, err)
+	assert.Len(t, relationships, 3)
+
+	byID := make(map[string]users.Relationship)
+	for _, relationship := range relationships {
+		byID[relationship.UserID] = relationship
+	}
+	assert.Equal(t, users.Relationship{UserID: "b", Follows: true, FollowedBy: true, Mutual: true}, byID["b"])
+	assert.Equal(t, users.Relationship{UserID: "c", FollowedBy: true}, byID["c"])
+	assert.Equal(t, users.Relationship{UserID: "d", Blocked: true}, byID["d"])
+}
+
+func TestUserRepository_GetMutuals_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{{ID: "a", Nickname: "a"}, {ID: "b", Nickname: "b"}, {ID: "c", Nickname: "c"}, {ID: "d", Nickname: "d", Disabled: true}}
+	for _, user := range testUsers {
+		firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+		firebaseMock.On("GetUserPictureUrl", ctx, user.ID, mock.Anything).Return("")
+	}
+	_ = db.Create(&testUsers)
+	_ = db.Model(&testUsers[1]).Association("Followers").Append(&testUsers[0])
+	_ = db.Model(&testUsers[2]).Association("Followers").Append(&testUsers[0])
+	_ = db.Model(&testUsers[0]).Association("Followers").Append(&testUsers[1])
+	_ = db.Model(&testUsers[3]).Association("Followers").Append(&testUsers[0])
+	_ = db.Model(&testUsers[0]).Association("Followers").Append(&testUsers[3])
+
+	res, err := repo.GetMutuals(ctx, users.GetMutualsRequest{UserID: "a"})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Mutuals, 1)
+	assert.Equal(t, "b", res.Mutuals[0].ID)
+}
+
+func TestUserRepository_FollowCounts_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{{ID: "a", Nickname: "a"}, {ID: "b", Nickname: "b"}}
+	_ = db.Create(&testUsers)
+
+	assert.NoError(t, repo.FollowUser(ctx, testUsers[0], testUsers[1], models.FollowSourceProfile))
+	assert.ErrorIs(t, repo.FollowUser(ctx, testUsers[0], testUsers[1], models.FollowSourceProfile), contracts.ErrAlreadyFollowing)
+
+	var followed, follower models.User
+	_ = db.First(&followed, "id = ?", "a")
+	_ = db.First(&follower, "id = ?", "b")
+	assert.Equal(t, int64(1), followed.FollowersCount)
+	assert.Equal(t, int64(1), follower.FollowingCount)
+
+	assert.NoError(t, repo.SetDisabled(ctx, "b", true))
+	_ = db.First(&followed, "id = ?", "a")
+	assert.Equal(t, int64(0), followed.FollowersCount)
+
+	assert.NoError(t, repo.SetDisabled(ctx, "b", false))
+	assert.NoError(t, repo.UnfollowUser(ctx, "a", "b"))
+	assert.ErrorIs(t, repo.UnfollowUser(ctx, "a", "b"), contracts.ErrNotFollowing)
+	_ = db.First(&followed, "id = ?", "a")
+	_ = db.First(&follower, "id = ?", "b")
+	assert.Equal(t, int64(0), followed.FollowersCount)
+	assert.Equal(t, int64(0), follower.FollowingCount)
+}
+
+func TestUserRepository_ReconcileFollowCounts_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{{ID: "a", Nickname: "a", FollowersCount: 7}, {ID: "b", Nickname: "b"}, {ID: "c", Nickname: "c"}}
+	_ = db.Create(&testUsers)
+	_ = db.Model(&testUsers[0]).Association("Followers").Append(&testUsers[1], &testUsers[2])
+
+	repaired, err := repo.ReconcileFollowCounts(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), repaired)
+
+	var user models.User
+	_ = db.First(&user, "id = ?", "a")
+	assert.Equal(t, int64(2), user.FollowersCount)
+	_ = db.First(&user, "id = ?", "b")
+	assert.Equal(t, int64(1), user.FollowingCount)
+}
+
+func TestUserRepository_BatchFollow_Ok(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{{ID: "a", Nickname: "a"}, {ID: "b", Nickname: "b"}, {ID: "c", Nickname: "c"}, {ID: "d", Nickname: "d"}}
+	_ = db.Create(&testUsers)
+	_ = db.Model(&testUsers[2]).Association("Followers").Append(&testUsers[0])
+	_ = db.Model(&testUsers[3]).Association("Followers").Append(&testUsers[0])
+
+	req := users.BatchFollowRequest{FollowerUserID: "a", Follow: []string{"b", "c", "a", "unknown"}, Unfollow: []string{"d"}}
+	res, followed, err := repo.BatchFollow(ctx, testUsers[0], req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []users.BatchFollowResult{
+		{UserID: "b", Status: users.BatchFollowStatusFollowed},
+		{UserID: "c", Status: users.BatchFollowStatusAlreadyFollowed},
+		{UserID: "a", Status: users.BatchFollowStatusSelf},
+		{UserID: "unknown", Status: users.BatchFollowStatusNotFound},
+		{UserID: "d", Status: users.BatchFollowStatusUnfollowed},
+	}, res.Results)
+	assert.Len(t, followed, 1)
+	assert.Equal(t, "b", followed[0].ID)
+
+	var user models.User
+	_ = db.First(&user, "id = ?", "b")
+	assert.Equal(t, int64(1), user.FollowersCount)
+}
+
+func TestUserRepository_GetFollowers_OrderAndDateRange(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, mock.Anything).Return(false, nil)
+	firebaseMock.On("GetUserPictureUrl", ctx, mock.Anything, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	testUsers := []models.User{{ID: "a", Nickname: "a"}, {ID: "b", Nickname: "b"}, {ID: "c", Nickname: "c"}, {ID: "d", Nickname: "d"}}
+	_ = db.Create(&testUsers)
+	now := time.Now().UTC().Truncate(time.Second)
+	follows := []models.Follow{
+		{UserID: "a", FollowerID: "b", CreatedAt: now.Add(-72 * time.Hour), Source: models.FollowSourceSearch},
+		{UserID: "a", FollowerID: "c", CreatedAt: now.Add(-48 * time.Hour), Source: models.FollowSourceProfile},
+		{UserID: "a", FollowerID: "d", CreatedAt: now.Add(-24 * time.Hour), Source: models.FollowSourceSuggestion},
+	}
+	_ = db.Create(&follows)
+
+	res, err := repo.GetFollowers(ctx, users.GetUserFollowersRequest{UserID: "a", Order: users.FollowOrderNewest})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), res.Pagination.TotalRows)
+	assert.Equal(t, "d", res.Followers[0].ID)
+	assert.True(t, follows[2].CreatedAt.Equal(*res.Followers[0].FollowedAt))
+
+	from := now.Add(-60 * time.Hour)
+	res, err = repo.GetFollowers(ctx, users.GetUserFollowersRequest{UserID: "a", Order: users.FollowOrderOldest, From: &from})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), res.Pagination.TotalRows)
+	assert.Equal(t, "c", res.Followers[0].ID)
+
+	to := now.Add(-60 * time.Hour)
+	followed, err := repo.GetFollowed(ctx, users.GetFollowedUsersRequest{UserID: "b", Order: users.FollowOrderNewest, To: &to})
+	assert.NoError(t, err)
+	assert.Len(t, followed.Followed, 1)
+	assert.Equal(t, "a", followed.Followed[0].ID)
+}
+
+func TestUserRepository_GetFollowers_UserNotFound(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	_, err := repo.GetFollowers(ctx, users.GetUserFollowersRequest{UserID: "unknown"})
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserRepository_SetPictureUpdatedAt_OK(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	testUser := models.User{ID: "test", Nickname: "Arnold"}
+	pictureUrls := map[string]string{models.PictureSizeOriginal: "original", "small": "small"}
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("GetUserPictureUrls", ctx, testUser.ID, mock.AnythingOfType("time.Time")).Return(pictureUrls)
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	err := repo.SetPictureUpdatedAt(ctx, testUser.ID, time.Now())
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+
+	db.Create(&testUser)
+	err = repo.SetPictureUpdatedAt(ctx, testUser.ID, time.Now())
+	assert.NoError(t, err)
+
+	resultUser, err := repo.GetByID(ctx, testUser.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, pictureUrls, resultUser.PictureUrls)
+	assert.Equal(t, "original", resultUser.PictureUrl)
+}
+
+func TestUserRepository_GetByID_RecordsLegacyPicture(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	testUser := models.User{ID: "test", Nickname: "Arnold"}
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, testUser.ID).Return(true, nil).Once()
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, true).Return("legacy")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	db.Create(&testUser)
+	for i := 0; i < 2; i++ {
+		resultUser, err := repo.GetByID(ctx, testUser.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "legacy", resultUser.PictureUrl)
+	}
+	firebaseMock.AssertNumberOfCalls(t, "HasLegacyPicture", 1)
+}
+
+func TestUserRepository_CreateUser_RechecksDirectUpload(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	testUser := models.User{ID: "test", Nickname: "Arnold", Height: 180, Weight: 80}
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("HasLegacyPicture", ctx, testUser.ID).Return(false, nil).Once()
+	firebaseMock.On("HasLegacyPicture", ctx, testUser.ID).Return(true, nil).Once()
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, false).Return("default")
+	firebaseMock.On("GetUserPictureUrl", ctx, testUser.ID, true).Return("uploaded")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	createdUser, err := repo.CreateUser(ctx, testUser)
+	assert.NoError(t, err)
+	assert.Equal(t, "default", createdUser.PictureUrl)
+
+	// the missing picture is trusted until the recheck interval passes
+	resultUser, err := repo.GetByID(ctx, testUser.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "default", resultUser.PictureUrl)
+	firebaseMock.AssertNumberOfCalls(t, "HasLegacyPicture", 1)
+
+	db.Model(&models.User{}).Where("id = ?", testUser.ID).UpdateColumn("picture_checked_at", time.Now().Add(-2*legacyPictureRecheckInterval))
+	for i := 0; i < 2; i++ {
+		resultUser, err := repo.GetByID(ctx, testUser.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "uploaded", resultUser.PictureUrl)
+	}
+	firebaseMock.AssertNumberOfCalls(t, "HasLegacyPicture", 2)
+}
+
+func TestUserRepository_BackfillLocations(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	repo := NewUserRepository(db, zaptest.NewLogger(t), new(mocks.Firebase), new(mocks.IdentityProvider), reverseLocator)
+
+	db.Create(&models.User{ID: "a", Nickname: "a", Latitude: 40.416775, Longitude: -3.703790})
+	db.Create(&models.User{ID: "b", Nickname: "b", Latitude: 0, Longitude: 0})
+	db.Create(&models.User{ID: "c", Nickname: "c", Latitude: 48.8566, Longitude: 2.3522})
+
+	updated, lastID, err := repo.BackfillLocations(ctx, "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, "b", lastID)
+
+	updated, lastID, err = repo.BackfillLocations(ctx, lastID, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, "c", lastID)
+
+	updated, lastID, err = repo.BackfillLocations(ctx, lastID, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, updated)
+	assert.Equal(t, "", lastID)
+
+	var stored models.User
+	db.First(&stored, "id = ?", "a")
+	assert.Equal(t, "Spain (ESP), Europe", stored.MainLocation)
+	assert.Equal(t, utils.LocationDatasetVersion, stored.LocationVersion)
+
+	// unresolved coordinates stay outdated for the next backfill
+	db.First(&stored, "id = ?", "b")
+	assert.Equal(t, "", stored.MainLocation)
+	assert.Equal(t, 0, stored.LocationVersion)
+	updated, lastID, err = repo.BackfillLocations(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, updated)
+	assert.Equal(t, "b", lastID)
+}
+
+func TestUserRepository_Update_StoresLocation(t *testing.T) {
+	defer testSuite.TruncateModels()
+	ctx := context.Background()
+	db := testSuite.DB
+	reverseLocator, _ := utils.NewReverseLocator()
+	firebaseMock := new(mocks.Firebase)
+	firebaseMock.On("GetUserPictureUrl", ctx, mock.Anything, mock.Anything).Return("")
+	repo := NewUserRepository(db, zaptest.NewLogger(t), firebaseMock, new(mocks.IdentityProvider), reverseLocator)
+
+	user, err := repo.CreateUser(ctx, models.User{ID: "a", Nickname: "a", Latitude: 40.416775, Longitude: -3.703790})
+	assert.NoError(t, err)
+	assert.Equal(t, "Spain (ESP), Europe", user.MainLocation)
+
+	user.Latitude = -34.6
+	user.Longitude = -58.38
+	user, err = repo.Update(ctx, user)
+	assert.NoError(t, err)
+	assert.Equal(t, "Argentina (ARG), South America", user.MainLocation)
+
+	var stored models.User
+	db.First(&stored, "id = ?", "a")
+	assert.Equal(t, user.MainLocation, stored.MainLocation)
+}
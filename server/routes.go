@@ -9,7 +9,7 @@ import (
 )
 
 func (s *Server) InitRoutes() {
-	baseRouter := s.router.Group("/:version")
+	baseRouter := s.router.Group("/:version", middleware.BindLanguage())
 	userRouter := baseRouter.Group("/users")
 	adminRouter := baseRouter.Group("/admin")
 
@@ -17,12 +17,27 @@ func (s *Server) InitRoutes() {
 	s.InitUserRoutes(userRouter)
 	s.InitAdminRoutes(adminRouter)
 
+	if s.servesBlobs {
+		s.InitBlobRoutes(baseRouter.Group("/blobs"))
+	}
+
 }
 
 func (s *Server) InitDocRoutes(router *gin.RouterGroup) {
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
 
+// InitBlobRoutes serves the local blob storage, only used when there is no bucket.
+func (s *Server) InitBlobRoutes(router *gin.RouterGroup) {
+	router.GET("/*path", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getBlob.Handle(),
+	}))
+
+	router.PUT("/*path", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.putBlob.Handle(),
+	}))
+}
+
 func (s *Server) InitUserRoutes(router *gin.RouterGroup) {
 	router.POST("/register", middleware.HandleByVersion(middleware.VersionHandlers{
 		"v1": s.register.Handle(),
@@ -40,6 +55,10 @@ func (s *Server) InitUserRoutes(router *gin.RouterGroup) {
 		"v1": s.updateUser.Handle(),
 	}))
 
+	router.PUT("/:userID/picture", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.updateUserPicture.Handle(),
+	}))
+
 	router.DELETE("/:userID", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
 		"v1": s.deleteUser.Handle(),
 	}))
@@ -64,10 +83,58 @@ func (s *Server) InitUserRoutes(router *gin.RouterGroup) {
 		"v1": s.getFollowedUsers.Handle(),
 	}))
 
+	router.POST("/:userID/followed/batch", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.batchFollow.Handle(),
+	}))
+
+	router.GET("/:userID/trainer-profile", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getTrainerProfile.Handle(),
+	}))
+
+	router.PUT("/:userID/trainer-profile", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.updateTrainerProfile.Handle(),
+	}))
+
+	router.POST("/:userID/reviews", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.createTrainerReview.Handle(),
+	}))
+
+	router.GET("/:userID/reviews", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getTrainerReviews.Handle(),
+	}))
+
+	router.PATCH("/:userID/reviews/:reviewerID", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.updateTrainerReview.Handle(),
+	}))
+
+	router.GET("/:userID/measurements", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getMeasurements.Handle(),
+	}))
+
+	router.GET("/:userID/mutuals", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getMutuals.Handle(),
+	}))
+
+	router.GET("/:userID/relationships", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getRelationships.Handle(),
+	}))
+
+	router.GET("/:userID/relationships/:otherUserID", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getRelationship.Handle(),
+	}))
+
 	router.GET("/:userID/closest", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
 		"v1": s.getClosestUsers.Handle(),
 	}))
 
+	router.GET("/:userID/recommendations", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getRecommendations.Handle(),
+	}))
+
+	router.GET("/:userID/follow-suggestions", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getFollowSuggestions.Handle(),
+	}))
+
 	router.POST("/:userID/enable", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
 		"v1": s.enableUser.Handle(),
 	}))
@@ -84,6 +151,10 @@ func (s *Server) InitUserRoutes(router *gin.RouterGroup) {
 		"v1": s.notifyUserLogin.Handle(),
 	}))
 
+	router.POST("/sign-in", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.userLogin.Handle(),
+	}))
+
 	router.POST("/:userID/verification/send", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
 		"v1": s.sendVerificationPin.Handle(),
 	}))
@@ -103,6 +174,10 @@ func (s *Server) InitUserRoutes(router *gin.RouterGroup) {
 	router.PUT("/certifications/:certificationID", middleware.HandleByVersion(middleware.VersionHandlers{
 		"v1": s.updateCert.Handle(),
 	}))
+
+	router.GET("/interests", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.getInterests.Handle(),
+	}))
 }
 
 func (s *Server) InitAdminRoutes(router *gin.RouterGroup) {
@@ -113,4 +188,28 @@ func (s *Server) InitAdminRoutes(router *gin.RouterGroup) {
 	router.POST("/login", middleware.HandleByVersion(middleware.VersionHandlers{
 		"v1": s.adminLogin.Handle(),
 	}))
+
+	router.PUT("/users/:userID/nickname", middleware.BindUserIDFromUri(), middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.forceRenameUser.Handle(),
+	}))
+
+	router.DELETE("/reviews/:reviewID", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.deleteTrainerReview.Handle(),
+	}))
+
+	router.POST("/followers/reconcile", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.reconcileFollowCounts.Handle(),
+	}))
+
+	router.POST("/interests", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.createInterest.Handle(),
+	}))
+
+	router.PATCH("/interests/:interestName", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.updateInterest.Handle(),
+	}))
+
+	router.DELETE("/interests/:interestName", middleware.HandleByVersion(middleware.VersionHandlers{
+		"v1": s.deactivateInterest.Handle(),
+	}))
 }
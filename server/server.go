@@ -1,17 +1,22 @@
 package server
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/fiufit/users/database"
 	"github.com/fiufit/users/handlers"
+	"github.com/fiufit/users/jobs"
 	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/repositories"
 	"github.com/fiufit/users/repositories/external"
 	"github.com/fiufit/users/usecases/accounts"
 	"github.com/fiufit/users/usecases/certifications"
+	"github.com/fiufit/users/usecases/interests"
+	"github.com/fiufit/users/usecases/trainers"
 	"github.com/fiufit/users/usecases/users"
 	"github.com/fiufit/users/utils"
 	"github.com/gin-gonic/gin"
@@ -21,16 +26,21 @@ import (
 
 type Server struct {
 	router                *gin.Engine
+	scheduler             *jobs.Scheduler
 	register              handlers.Register
 	finishRegister        handlers.FinishRegister
 	adminRegister         handlers.AdminRegister
 	adminLogin            handlers.AdminLogin
+	userLogin             handlers.UserLogin
 	getUserByID           handlers.GetUserByID
 	getUsers              handlers.GetUsers
 	updateUser            handlers.UpdateUser
+	updateUserPicture     handlers.UpdateUserPicture
 	deleteUser            handlers.DeleteUser
+	forceRenameUser       handlers.ForceRenameUser
 	followUser            handlers.FollowUser
 	unfollowUser          handlers.UnfollowUser
+	batchFollow           handlers.BatchFollow
 	getUserFollowers      handlers.GetUserFollowers
 	getFollowedUsers      handlers.GetFollowedUsers
 	enableUser            handlers.EnableUser
@@ -38,14 +48,35 @@ type Server struct {
 	notifyUserLogin       handlers.NotifyUserLogin
 	notifyPasswordRecover handlers.NotifyPasswordRecover
 	getClosestUsers       handlers.GetClosestUsers
+	getRecommendations    handlers.GetUserRecommendations
+	getFollowSuggestions  handlers.GetFollowSuggestions
+	getRelationship       handlers.GetRelationship
+	getRelationships      handlers.GetRelationships
+	getMutuals            handlers.GetMutuals
+	getMeasurements       handlers.GetMeasurements
+	getTrainerProfile     handlers.GetTrainerProfile
+	updateTrainerProfile  handlers.UpdateTrainerProfile
+	createTrainerReview   handlers.CreateTrainerReview
+	updateTrainerReview   handlers.UpdateTrainerReview
+	getTrainerReviews     handlers.GetTrainerReviews
+	deleteTrainerReview   handlers.DeleteTrainerReview
+	reconcileFollowCounts handlers.ReconcileFollowCounts
 	sendVerificationPin   handlers.SendVerificationPin
 	verifyUser            handlers.VerifyUser
 	createCert            handlers.CreateCertification
 	updateCert            handlers.UpdateCertification
 	getCert               handlers.GetCertifications
+	getInterests          handlers.GetInterests
+	createInterest        handlers.CreateInterest
+	updateInterest        handlers.UpdateInterest
+	deactivateInterest    handlers.DeactivateInterest
+	servesBlobs           bool
+	getBlob               handlers.GetBlob
+	putBlob               handlers.PutBlob
 }
 
 func (s *Server) Run() {
+	s.scheduler.Start(context.Background())
 	err := s.router.Run(fmt.Sprintf("0.0.0.0:%v", os.Getenv("SERVICE_PORT")))
 	if err != nil {
 		panic(err)
@@ -58,17 +89,43 @@ func NewServer() *Server {
 		panic(err)
 	}
 
+	err = database.MigrateNicknames(db)
+	if err != nil {
+		panic(err)
+	}
+
 	err = db.AutoMigrate(
 		&models.User{},
 		&models.Administrator{},
 		&models.Interest{},
+		&models.InterestTranslation{},
 		&models.VerificationPin{},
 		&models.Certification{},
+		&models.TrainerProfile{},
+		&models.TrainerLink{},
+		&models.TrainerReview{},
+		&models.NicknameChange{},
+		&models.Identity{},
 	)
 	if err != nil {
 		panic(err)
 	}
 
+	err = database.MigrateFollows(db)
+	if err != nil {
+		panic(err)
+	}
+
+	err = database.MigrateMeasurements(db)
+	if err != nil {
+		panic(err)
+	}
+
+	err = database.MigrateCertificationTransitions(db)
+	if err != nil {
+		panic(err)
+	}
+
 	logger, _ := zap.NewDevelopment()
 
 	sdkJson, err := base64.StdEncoding.DecodeString(os.Getenv("FIREBASE_B64_SDK_JSON"))
@@ -94,54 +151,159 @@ func NewServer() *Server {
 
 	whatsAppSender := utils.NewWhatsApperImpl(os.Getenv("TWILIO_PHONE_NUMBER"), twilio.NewRestClient())
 
+	nicknamePolicy := models.NewNicknamePolicy(
+		models.NicknameWordsFromEnv(os.Getenv("RESERVED_NICKNAMES"), models.DefaultReservedNicknames),
+		models.NicknameWordsFromEnv(os.Getenv("PROFANE_NICKNAME_WORDS"), models.DefaultProfaneWords),
+	)
+	nicknamePolicy.HoldPeriod = jobs.IntervalFromEnv(os.Getenv("NICKNAME_HOLD_PERIOD"), 30*24*time.Hour)
+	nicknamePolicy.ChangeInterval = jobs.IntervalFromEnv(os.Getenv("NICKNAME_CHANGE_INTERVAL"), 7*24*time.Hour)
+
 	metricsUrl := os.Getenv("METRICS_SERVICE_URL")
 	notificationUrl := os.Getenv("NOTIFICATION_SERVICE_URL")
 
 	// REPOSITORIES
-	firebaseRepo, err := external.NewFirebaseRepository(logger, sdkJson, os.Getenv("FIREBASE_BUCKET_NAME"))
+	var blobStorage external.BlobStorage
+	var localBlobStorage external.LocalBlobStorage
+	servesBlobs := os.Getenv("BLOB_STORAGE") == "local"
+	if servesBlobs {
+		localBlobStorage, err = external.NewLocalBlobStorage(envOrDefault("LOCAL_STORAGE_DIR", "storage"), os.Getenv("LOCAL_STORAGE_URL"), []byte(os.Getenv("LOCAL_STORAGE_SECRET")))
+		blobStorage = localBlobStorage
+	} else {
+		blobStorage, err = external.NewGCSBlobStorage(context.Background(), sdkJson, os.Getenv("FIREBASE_BUCKET_NAME"))
+	}
 	if err != nil {
 		panic(err)
 	}
-	userRepo := repositories.NewUserRepository(db, logger, firebaseRepo, reverseLocator)
+
+	var identityProvider external.IdentityProvider
+	if os.Getenv("IDENTITY_PROVIDER") == "local" {
+		identityProvider = repositories.NewIdentityRepository(db, logger, toker)
+	} else {
+		identityProvider, err = external.NewFirebaseIdentityProvider(logger, sdkJson)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	firebaseRepo := external.NewFirebaseRepository(logger, blobStorage)
+	userRepo := repositories.NewUserRepository(db, logger, firebaseRepo, identityProvider, reverseLocator)
 	adminRepo := repositories.NewAdminRepository(db, logger)
 	metricsRepo := external.NewMetricsRepository(metricsUrl, "v1", logger)
 	notificationRepo := external.NewNotificationRepository(notificationUrl, logger, "v1")
 	verificationRepo := repositories.NewVerificationPinRepository(db, logger)
 	certificationRepo := repositories.NewCertificationRepository(db, logger, firebaseRepo)
+	interestRepo := repositories.NewInterestRepository(db, logger)
+	trainerProfileRepo := repositories.NewTrainerProfileRepository(db, logger)
+	trainerReviewRepo := repositories.NewTrainerReviewRepository(db, logger)
+	measurementRepo := repositories.NewMeasurementRepository(db, logger)
+	nicknameChangeRepo := repositories.NewNicknameChangeRepository(db, logger)
+
+	err = interestRepo.SeedDefaults(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	models.SetInterestCatalog(interestRepo)
 
 	// USECASES
-	registerUc := accounts.NewRegisterImpl(userRepo, logger, firebaseRepo, metricsRepo)
+	registerUc := accounts.NewRegisterImpl(userRepo, nicknameChangeRepo, logger, identityProvider, firebaseRepo, metricsRepo, nicknamePolicy)
 	adminRegisterUc := accounts.NewAdminRegistererImpl(adminRepo, logger, toker)
-	getUserUc := users.NewUserGetterImpl(userRepo, logger)
-	updateUserUc := users.NewUserUpdaterImpl(userRepo, metricsRepo)
+	getUserUc := users.NewUserGetterImpl(userRepo, nicknameChangeRepo, nicknamePolicy, logger)
+	updateUserUc := users.NewUserUpdaterImpl(userRepo, nicknameChangeRepo, metricsRepo, nicknamePolicy)
+	renameUserUc := users.NewUserRenamerImpl(userRepo)
+	updatePictureUc := users.NewUserPictureUpdaterImpl(userRepo, firebaseRepo)
 	deleteUserUc := users.NewUserDeleterImpl(userRepo)
+	recommendUsersUc := users.NewUserRecommenderImpl(userRepo)
+	relationshipsUc := users.NewUserRelationshipsImpl(userRepo)
+	measurementsUc := users.NewUserMeasurementsImpl(userRepo, measurementRepo)
+	reconcileFollowCountsUc := users.NewFollowCountReconcilerImpl(userRepo, logger)
+	backfillLocationsUc := users.NewLocationBackfillerImpl(userRepo, logger)
 	followUserUc := users.NewUserFollowerImpl(userRepo, notificationRepo, metricsRepo, logger)
-	enableUserUc := users.NewUserEnablerImpl(userRepo, firebaseRepo, metricsRepo, logger)
-	verificationUc := accounts.NewVerifierImpl(verificationRepo, firebaseRepo, whatsAppSender, logger)
+	enableUserUc := users.NewUserEnablerImpl(userRepo, identityProvider, metricsRepo, logger)
+	verificationUc := accounts.NewVerifierImpl(verificationRepo, identityProvider, whatsAppSender, logger)
 	createCertUc := certifications.NewCertificationCreator(certificationRepo, userRepo)
-	updateCertUc := certifications.NewCertificationUpdaterImpl(certificationRepo, userRepo, notificationRepo, firebaseRepo, logger)
+	updateCertUc := certifications.NewCertificationUpdaterImpl(certificationRepo, userRepo, adminRepo, notificationRepo, firebaseRepo, logger)
 	getCertUc := certifications.NewCertificationGetterImpl(certificationRepo, userRepo)
+	expireCertsUc := certifications.NewCertificationExpirerImpl(certificationRepo, userRepo, notificationRepo, logger)
+	createInterestUc := interests.NewInterestCreatorImpl(interestRepo)
+	getInterestsUc := interests.NewInterestGetterImpl(interestRepo)
+	updateInterestUc := interests.NewInterestUpdaterImpl(interestRepo)
+	getTrainerProfileUc := trainers.NewTrainerProfileGetterImpl(trainerProfileRepo, userRepo)
+	updateTrainerProfileUc := trainers.NewTrainerProfileUpdaterImpl(trainerProfileRepo, userRepo)
+	trainerReviewsUc := trainers.NewTrainerReviewerImpl(trainerReviewRepo, userRepo)
 
 	// HANDLERS
 	register := handlers.NewRegister(&registerUc, logger)
 	finishRegister := handlers.NewFinishRegister(&registerUc, logger)
 	adminRegister := handlers.NewAdminRegister(&adminRegisterUc, logger)
 	adminLogin := handlers.NewAdminLogin(&adminRegisterUc, logger)
+	userLogin := handlers.NewUserLogin(&registerUc, logger)
 	sendVerificationPin := handlers.NewSendVerificationPin(&verificationUc, logger)
 	verifyUser := handlers.NewVerifyUser(&verificationUc, logger)
 
 	getUserByID := handlers.NewGetUserByID(&getUserUc, logger)
 	getUsers := handlers.NewGetUsers(&getUserUc, logger)
 	getClosestUsers := handlers.NewGetClosestUsers(&getUserUc, logger)
+	getRecommendations := handlers.NewGetUserRecommendations(recommendUsersUc, logger)
+	getFollowSuggestions := handlers.NewGetFollowSuggestions(recommendUsersUc, logger)
+	getRelationship := handlers.NewGetRelationship(relationshipsUc, logger)
+	getRelationships := handlers.NewGetRelationships(relationshipsUc, logger)
+	getMutuals := handlers.NewGetMutuals(relationshipsUc, logger)
+	getMeasurements := handlers.NewGetMeasurements(measurementsUc, logger)
+	getTrainerProfile := handlers.NewGetTrainerProfile(getTrainerProfileUc)
+	updateTrainerProfile := handlers.NewUpdateTrainerProfile(updateTrainerProfileUc)
+	createTrainerReview := handlers.NewCreateTrainerReview(trainerReviewsUc)
+	updateTrainerReview := handlers.NewUpdateTrainerReview(trainerReviewsUc)
+	getTrainerReviews := handlers.NewGetTrainerReviews(trainerReviewsUc)
+	deleteTrainerReview := handlers.NewDeleteTrainerReview(trainerReviewsUc)
+	reconcileFollowCounts := handlers.NewReconcileFollowCounts(reconcileFollowCountsUc)
+
+	// JOBS
+	scheduler := jobs.NewScheduler(logger)
+	scheduler.Add(jobs.Job{
+		Name:     "reconcile_follow_counts",
+		Interval: jobs.IntervalFromEnv(os.Getenv("FOLLOW_COUNTS_RECONCILE_INTERVAL"), 24*time.Hour),
+		Run: func(ctx context.Context) error {
+			_, err := reconcileFollowCountsUc.ReconcileFollowCounts(ctx)
+			return err
+		},
+	})
+	scheduler.Add(jobs.Job{
+		Name:       "backfill_locations",
+		Interval:   jobs.IntervalFromEnv(os.Getenv("LOCATION_BACKFILL_INTERVAL"), 24*time.Hour),
+		RunOnStart: true,
+		Run: func(ctx context.Context) error {
+			_, err := backfillLocationsUc.BackfillLocations(ctx)
+			return err
+		},
+	})
+	scheduler.Add(jobs.Job{
+		Name:       "expire_certifications",
+		Interval:   jobs.IntervalFromEnv(os.Getenv("CERTIFICATION_EXPIRY_INTERVAL"), time.Hour),
+		RunOnStart: true,
+		Run: func(ctx context.Context) error {
+			_, err := expireCertsUc.ExpireCertifications(ctx)
+			return err
+		},
+	})
 	updateUser := handlers.NewUpdateUser(&updateUserUc, logger)
+	updateUserPicture := handlers.NewUpdateUserPicture(updatePictureUc, logger)
 	deleteUser := handlers.NewDeleteUser(&deleteUserUc, logger)
+	forceRenameUser := handlers.NewForceRenameUser(renameUserUc, logger)
 
 	createCertification := handlers.NewCreateCertification(createCertUc)
 	updateCertification := handlers.NewUpdateCertification(updateCertUc)
 	getCertifications := handlers.NewGetCertifications(getCertUc)
 
+	getInterests := handlers.NewGetInterests(getInterestsUc)
+	createInterest := handlers.NewCreateInterest(createInterestUc)
+	updateInterest := handlers.NewUpdateInterest(updateInterestUc)
+	deactivateInterest := handlers.NewDeactivateInterest(updateInterestUc)
+	getBlob := handlers.NewGetBlob(localBlobStorage, logger)
+	putBlob := handlers.NewPutBlob(localBlobStorage, logger)
+
 	followUser := handlers.NewFollowUser(&followUserUc, logger)
 	unfollowUser := handlers.NewUnfollowUser(&followUserUc, logger)
+	batchFollow := handlers.NewBatchFollow(&followUserUc, logger)
 	getUserFollowers := handlers.NewGetUserFollowers(&getUserUc, logger)
 	getFollowedUsers := handlers.NewGetFollowedUsers(&getUserUc, logger)
 	enableUser := handlers.NewEnableUser(&enableUserUc, logger)
@@ -151,21 +313,39 @@ func NewServer() *Server {
 
 	return &Server{
 		router:                gin.Default(),
+		scheduler:             scheduler,
 		register:              register,
 		finishRegister:        finishRegister,
 		adminRegister:         adminRegister,
 		adminLogin:            adminLogin,
+		userLogin:             userLogin,
 		getUserByID:           getUserByID,
 		getUsers:              getUsers,
 		updateUser:            updateUser,
+		updateUserPicture:     updateUserPicture,
 		deleteUser:            deleteUser,
+		forceRenameUser:       forceRenameUser,
 		followUser:            followUser,
 		unfollowUser:          unfollowUser,
+		batchFollow:           batchFollow,
 		getUserFollowers:      getUserFollowers,
 		getFollowedUsers:      getFollowedUsers,
 		enableUser:            enableUser,
 		disableUser:           disableUser,
 		getClosestUsers:       getClosestUsers,
+		getRecommendations:    getRecommendations,
+		getFollowSuggestions:  getFollowSuggestions,
+		getRelationship:       getRelationship,
+		getRelationships:      getRelationships,
+		getMutuals:            getMutuals,
+		getMeasurements:       getMeasurements,
+		getTrainerProfile:     getTrainerProfile,
+		updateTrainerProfile:  updateTrainerProfile,
+		createTrainerReview:   createTrainerReview,
+		updateTrainerReview:   updateTrainerReview,
+		getTrainerReviews:     getTrainerReviews,
+		deleteTrainerReview:   deleteTrainerReview,
+		reconcileFollowCounts: reconcileFollowCounts,
 		notifyUserLogin:       notifyUserLogin,
 		notifyPasswordRecover: notifyPasswordRecover,
 		sendVerificationPin:   sendVerificationPin,
@@ -173,5 +353,19 @@ func NewServer() *Server {
 		createCert:            createCertification,
 		updateCert:            updateCertification,
 		getCert:               getCertifications,
+		getInterests:          getInterests,
+		createInterest:        createInterest,
+		updateInterest:        updateInterest,
+		deactivateInterest:    deactivateInterest,
+		servesBlobs:           servesBlobs,
+		getBlob:               getBlob,
+		putBlob:               putBlob,
+	}
+}
+
+func envOrDefault(key string, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
 	}
+	return def
 }
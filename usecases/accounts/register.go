@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/accounts"
 	"github.com/fiufit/users/contracts/metrics"
 	"github.com/fiufit/users/models"
@@ -15,21 +16,25 @@ import (
 type Registerer interface {
 	Register(ctx context.Context, req accounts.RegisterRequest) (accounts.RegisterResponse, error)
 	FinishRegister(ctx context.Context, req accounts.FinishRegisterRequest) (accounts.FinishRegisterResponse, error)
+	Login(ctx context.Context, req accounts.LoginRequest) (accounts.LoginResponse, error)
 }
 
 type RegistererImpl struct {
-	users   repositories.Users
-	metrics external.Metrics
-	logger  *zap.Logger
-	auth    external.Firebase
+	users           repositories.Users
+	nicknameChanges repositories.NicknameChanges
+	metrics         external.Metrics
+	logger          *zap.Logger
+	identity        external.IdentityProvider
+	firebase        external.Firebase
+	nicknames       models.NicknamePolicy
 }
 
-func NewRegisterImpl(users repositories.Users, logger *zap.Logger, auth external.Firebase, metrics external.Metrics) RegistererImpl {
-	return RegistererImpl{users: users, metrics: metrics, logger: logger, auth: auth}
+func NewRegisterImpl(users repositories.Users, nicknameChanges repositories.NicknameChanges, logger *zap.Logger, identity external.IdentityProvider, firebase external.Firebase, metrics external.Metrics, nicknames models.NicknamePolicy) RegistererImpl {
+	return RegistererImpl{users: users, nicknameChanges: nicknameChanges, metrics: metrics, logger: logger, identity: identity, firebase: firebase, nicknames: nicknames}
 }
 
 func (uc *RegistererImpl) Register(ctx context.Context, req accounts.RegisterRequest) (accounts.RegisterResponse, error) {
-	newUserID, err := uc.auth.Register(ctx, req)
+	newUserID, err := uc.identity.Register(ctx, req)
 	if err != nil {
 		return accounts.RegisterResponse{}, err
 	}
@@ -38,6 +43,15 @@ func (uc *RegistererImpl) Register(ctx context.Context, req accounts.RegisterReq
 }
 
 func (uc *RegistererImpl) FinishRegister(ctx context.Context, req accounts.FinishRegisterRequest) (accounts.FinishRegisterResponse, error) {
+	validationErr := &contracts.ValidationError{}
+	validationErr.Add("nickname", uc.nicknames.Check(req.Nickname))
+	if err := validationErr.Err(); err != nil {
+		return accounts.FinishRegisterResponse{}, err
+	}
+
+	if err := uc.checkNicknameHold(ctx, req.Nickname, req.UserID); err != nil {
+		return accounts.FinishRegisterResponse{}, err
+	}
 
 	usr := models.User{
 		ID:                req.UserID,
@@ -46,19 +60,21 @@ func (uc *RegistererImpl) FinishRegister(ctx context.Context, req accounts.Finis
 		IsMale:            *req.IsMale,
 		CreatedAt:         time.Now(),
 		BornAt:            req.BirthDate,
-		Height:            req.Height,
-		Weight:            req.Weight,
+		Height:            models.HeightToMetric(req.Height, req.Units),
+		Weight:            models.WeightToMetric(req.Weight, req.Units),
+		Units:             req.Units,
 		IsVerifiedTrainer: false,
 		Latitude:          *req.Latitude,
 		Longitude:         *req.Longitude,
 		Interests:         req.Interests,
+		Language:          req.Language,
 	}
 	createdUser, err := uc.users.CreateUser(ctx, usr)
 	if err != nil {
 		return accounts.FinishRegisterResponse{}, err
 	}
 
-	createdUser.PictureUrl = uc.auth.GetUserPictureUrl(ctx, createdUser.ID)
+	createdUser.PictureUrl = uc.firebase.GetUserPictureUrl(ctx, createdUser.ID, false)
 
 	registerMetricReq := metrics.CreateMetricRequest{
 		MetricType: "register",
@@ -68,9 +84,42 @@ func (uc *RegistererImpl) FinishRegister(ctx context.Context, req accounts.Finis
 
 	locationMetricReq := metrics.CreateMetricRequest{
 		MetricType: "location",
-		SubType:    usr.MainLocation,
+		SubType:    createdUser.MainLocation,
 	}
 	uc.metrics.Create(ctx, locationMetricReq)
 
 	return accounts.FinishRegisterResponse{User: createdUser}, nil
 }
+
+// checkNicknameHold keeps nicknames released by other users within the nickname hold period from new accounts, as
+// they still redirect to their previous owner.
+func (uc *RegistererImpl) checkNicknameHold(ctx context.Context, nickname string, userID string) error {
+	if uc.nicknames.HoldPeriod <= 0 {
+		return nil
+	}
+
+	changes, err := uc.nicknameChanges.GetByNickname(ctx, nickname, time.Now().Add(-uc.nicknames.HoldPeriod))
+	if err != nil {
+		return err
+	}
+	if len(changes) > 0 && changes[0].UserID != userID {
+		return contracts.ErrNicknameOnHold
+	}
+	return nil
+}
+
+// Login is only supported by identity providers that issue their own tokens.
+func (uc *RegistererImpl) Login(ctx context.Context, req accounts.LoginRequest) (accounts.LoginResponse, error) {
+	res, err := uc.identity.Login(ctx, req)
+	if err != nil {
+		return accounts.LoginResponse{}, err
+	}
+
+	loginMetricReq := metrics.CreateMetricRequest{
+		MetricType: "login",
+		SubType:    "mail",
+	}
+	uc.metrics.Create(ctx, loginMetricReq)
+
+	return res, nil
+}
@@ -6,11 +6,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/accounts"
 	"github.com/fiufit/users/contracts/metrics"
 	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/repositories/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/undefinedlabs/go-mpatch"
 	"go.uber.org/zap/zaptest"
 )
@@ -23,12 +25,12 @@ func TestRegisterOk(t *testing.T) {
 		Email:    "test@fiufit.com",
 		Password: "password",
 	}
-	firebaseRepo := new(mocks.Firebase)
+	identityRepo := new(mocks.IdentityProvider)
 	userRepo := new(mocks.Users)
 	metricsRepo := new(mocks.Metrics)
 
-	firebaseRepo.On("Register", ctx, req).Return(uid, nil)
-	registerUc := NewRegisterImpl(userRepo, zaptest.NewLogger(t), firebaseRepo, metricsRepo)
+	identityRepo.On("Register", ctx, req).Return(uid, nil)
+	registerUc := NewRegisterImpl(userRepo, new(mocks.NicknameChanges), zaptest.NewLogger(t), identityRepo, new(mocks.Firebase), metricsRepo, models.NicknamePolicy{})
 	res, err := registerUc.Register(ctx, req)
 
 	assert.NoError(t, err)
@@ -42,13 +44,13 @@ func TestRegisterError(t *testing.T) {
 		Email:    "test@fiufit.com",
 		Password: "password",
 	}
-	firebaseRepo := new(mocks.Firebase)
+	identityRepo := new(mocks.IdentityProvider)
 	userRepo := new(mocks.Users)
 	metricsRepo := new(mocks.Metrics)
 
-	firebaseRepo.On("Register", ctx, req).Return("", errors.New("repo error"))
+	identityRepo.On("Register", ctx, req).Return("", errors.New("repo error"))
 
-	registerUc := NewRegisterImpl(userRepo, zaptest.NewLogger(t), firebaseRepo, metricsRepo)
+	registerUc := NewRegisterImpl(userRepo, new(mocks.NicknameChanges), zaptest.NewLogger(t), identityRepo, new(mocks.Firebase), metricsRepo, models.NicknamePolicy{})
 	res, err := registerUc.Register(ctx, req)
 
 	assert.Equal(t, res.UserID, "")
@@ -115,8 +117,8 @@ func TestFinishRegisterOk(t *testing.T) {
 		return creationDate
 	})
 	userRepo.On("CreateUser", ctx, usr).Return(usr, nil)
-	firebaseRepo.On("GetUserPictureUrl", ctx, usr.ID).Return("")
-	registerUc := NewRegisterImpl(userRepo, zaptest.NewLogger(t), firebaseRepo, metricsRepo)
+	firebaseRepo.On("GetUserPictureUrl", ctx, usr.ID, false).Return("")
+	registerUc := NewRegisterImpl(userRepo, new(mocks.NicknameChanges), zaptest.NewLogger(t), new(mocks.IdentityProvider), firebaseRepo, metricsRepo, models.NicknamePolicy{})
 	_, err := registerUc.FinishRegister(ctx, req)
 
 	timePatch.Unpatch()
@@ -166,11 +168,64 @@ func TestFinishRegisterError(t *testing.T) {
 	})
 
 	userRepo.On("CreateUser", ctx, usr).Return(models.User{}, errors.New("repo error"))
-	firebaseRepo.On("GetUserPictureUrl", ctx, usr.ID).Return("")
-	registerUc := NewRegisterImpl(userRepo, zaptest.NewLogger(t), firebaseRepo, metricsRepo)
+	firebaseRepo.On("GetUserPictureUrl", ctx, usr.ID, false).Return("")
+	registerUc := NewRegisterImpl(userRepo, new(mocks.NicknameChanges), zaptest.NewLogger(t), new(mocks.IdentityProvider), firebaseRepo, metricsRepo, models.NicknamePolicy{})
 	res, err := registerUc.FinishRegister(ctx, req)
 
 	timePatch.Unpatch()
 	assert.Equal(t, res.User, models.User{})
 	assert.Error(t, err)
 }
+
+func TestFinishRegisterNicknameNotAllowed(t *testing.T) {
+	ctx := context.Background()
+	req := accounts.FinishRegisterRequest{UserID: "123456789", Nickname: "FiuFit_Support"}
+	policy := models.NewNicknamePolicy([]string{"fiufitsupport"}, nil)
+	registerUc := NewRegisterImpl(new(mocks.Users), new(mocks.NicknameChanges), zaptest.NewLogger(t), new(mocks.IdentityProvider), new(mocks.Firebase), new(mocks.Metrics), policy)
+
+	_, err := registerUc.FinishRegister(ctx, req)
+
+	var validationErr *contracts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestFinishRegisterNicknameOnHold(t *testing.T) {
+	ctx := context.Background()
+	req := accounts.FinishRegisterRequest{UserID: "123456789", Nickname: "released"}
+	nicknameChanges := new(mocks.NicknameChanges)
+	nicknameChanges.On("GetByNickname", ctx, req.Nickname, mock.Anything).Return([]models.NicknameChange{{UserID: "a", Nickname: "released"}}, nil)
+	policy := models.NicknamePolicy{HoldPeriod: time.Hour}
+	registerUc := NewRegisterImpl(new(mocks.Users), nicknameChanges, zaptest.NewLogger(t), new(mocks.IdentityProvider), new(mocks.Firebase), new(mocks.Metrics), policy)
+
+	_, err := registerUc.FinishRegister(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrNicknameOnHold)
+}
+
+func TestLoginOk(t *testing.T) {
+	ctx := context.Background()
+	req := accounts.LoginRequest{Email: "test@fiufit.com", Password: "password"}
+	identityRepo := new(mocks.IdentityProvider)
+	metricsRepo := new(mocks.Metrics)
+
+	identityRepo.On("Login", ctx, req).Return(accounts.LoginResponse{Token: "token"}, nil)
+	metricsRepo.On("Create", ctx, metrics.CreateMetricRequest{MetricType: "login", SubType: "mail"})
+	registerUc := NewRegisterImpl(new(mocks.Users), new(mocks.NicknameChanges), zaptest.NewLogger(t), identityRepo, new(mocks.Firebase), metricsRepo, models.NicknamePolicy{})
+	res, err := registerUc.Login(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "token", res.Token)
+	metricsRepo.AssertExpectations(t)
+}
+
+func TestLoginNotSupported(t *testing.T) {
+	ctx := context.Background()
+	req := accounts.LoginRequest{Email: "test@fiufit.com", Password: "password"}
+	identityRepo := new(mocks.IdentityProvider)
+
+	identityRepo.On("Login", ctx, req).Return(accounts.LoginResponse{}, contracts.ErrLoginNotSupported)
+	registerUc := NewRegisterImpl(new(mocks.Users), new(mocks.NicknameChanges), zaptest.NewLogger(t), identityRepo, new(mocks.Firebase), new(mocks.Metrics), models.NicknamePolicy{})
+	_, err := registerUc.Login(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrLoginNotSupported)
+}
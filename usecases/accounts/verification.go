@@ -23,16 +23,16 @@ type Verifier interface {
 type VerifierImpl struct {
 	verification   repositories.VerificationPins
 	logger         *zap.Logger
-	auth           external.Firebase
+	identity       external.IdentityProvider
 	whatsappSender utils.WhatsApper
 }
 
-func NewVerifierImpl(verification repositories.VerificationPins, auth external.Firebase, whatsAppSender utils.WhatsApper, logger *zap.Logger) VerifierImpl {
-	return VerifierImpl{logger: logger, auth: auth, verification: verification, whatsappSender: whatsAppSender}
+func NewVerifierImpl(verification repositories.VerificationPins, identity external.IdentityProvider, whatsAppSender utils.WhatsApper, logger *zap.Logger) VerifierImpl {
+	return VerifierImpl{logger: logger, identity: identity, verification: verification, whatsappSender: whatsAppSender}
 }
 
 func (uc *VerifierImpl) SendVerificationPin(ctx context.Context, req accounts.SendVerificationPinRequest) (models.VerificationPin, error) {
-	isVerified, err := uc.auth.UserIsVerified(ctx, req.UserID)
+	isVerified, err := uc.identity.UserIsVerified(ctx, req.UserID)
 	if err != nil {
 		return models.VerificationPin{}, err
 	}
@@ -73,6 +73,6 @@ func (uc *VerifierImpl) VerifyPin(ctx context.Context, req accounts.ValidateVeri
 	if time.Now().After(pin.ExpiresAt) {
 		return contracts.ErrVerificationPinExpired
 	}
-	err = uc.auth.VerifyUser(ctx, req.UserID)
+	err = uc.identity.VerifyUser(ctx, req.UserID)
 	return err
 }
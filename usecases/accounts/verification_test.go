@@ -21,7 +21,7 @@ import (
 
 func TestSendVerificationPin_ErrUserAlreadyVerified(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -41,7 +41,7 @@ func TestSendVerificationPin_ErrUserAlreadyVerified(t *testing.T) {
 
 func TestSendVerificationPin_AuthErr(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -60,7 +60,7 @@ func TestSendVerificationPin_AuthErr(t *testing.T) {
 
 func TestSendVerificationPin_WhatsApperError(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -116,7 +116,7 @@ func TestSendVerificationPin_WhatsApperError(t *testing.T) {
 
 func TestSendVerificationPin_Ok(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -173,7 +173,7 @@ func TestSendVerificationPin_Ok(t *testing.T) {
 
 func TestSendVerificationPin_VerificationCreateError(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -227,7 +227,7 @@ func TestSendVerificationPin_VerificationCreateError(t *testing.T) {
 
 func TestVerifyPin_RepoError(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -246,7 +246,7 @@ func TestVerifyPin_RepoError(t *testing.T) {
 
 func TestVerifyPin_PinDoesNotMatch(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -269,7 +269,7 @@ func TestVerifyPin_PinDoesNotMatch(t *testing.T) {
 
 func TestVerifyPin_PinExpired(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
@@ -293,7 +293,7 @@ func TestVerifyPin_PinExpired(t *testing.T) {
 
 func TestVerifyPin_Ok(t *testing.T) {
 	verification := new(mocks.VerificationPins)
-	auth := new(mocks.Firebase)
+	auth := new(mocks.IdentityProvider)
 	whatsappSender := new(mocks2.WhatsApper)
 	logger := zaptest.NewLogger(t)
 
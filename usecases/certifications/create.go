@@ -2,6 +2,8 @@ package certifications
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/certifications"
@@ -22,35 +24,54 @@ func NewCertificationCreator(certifications repositories.Certifications, users r
 	return CertificationCreatorImpl{certifications: certifications, users: users}
 }
 
+// Create submits a certification for review. A user whose last certification was denied resubmits it instead of
+// starting a new one, keeping its history. Revoked certifications are closed, so their users start a new one. Once
+// an approved certification is within its renewal window, or has expired, the new one renews it.
 func (uc CertificationCreatorImpl) Create(ctx context.Context, request certifications.CreateCertificationRequest) (models.Certification, error) {
 	user, err := uc.users.GetByID(ctx, request.UserID)
 	if err != nil {
 		return models.Certification{}, err
 	}
 
-	approvedCerts, err := uc.certifications.Get(ctx, certifications.GetCertificationsRequest{UserID: request.UserID, Status: models.CertificationStatusApproved})
-	if err != nil {
+	latestCert, err := uc.certifications.GetLatest(ctx, request.UserID)
+	if err != nil && !errors.Is(err, contracts.ErrCertificationNotFound) {
 		return models.Certification{}, err
 	}
 
-	if len(approvedCerts.Certifications) > 0 {
-		return models.Certification{}, contracts.ErrUserAlreadyCertified
+	cert := models.Certification{Status: models.CertificationStatusPending, UserID: request.UserID}
+	if err == nil {
+		switch {
+		case latestCert.Status == models.CertificationStatusApproved && !latestCert.RenewalOpen(time.Now()):
+			return models.Certification{}, contracts.ErrUserAlreadyCertified
+		case models.IsOpenCertificationStatus(latestCert.Status):
+			return models.Certification{}, contracts.ErrPendingCertsExists
+		case latestCert.Status == models.CertificationStatusDenied:
+			return uc.resubmit(ctx, latestCert, user)
+		case latestCert.Status == models.CertificationStatusApproved || latestCert.Status == models.CertificationStatusExpired:
+			cert.RenewalOfID = &latestCert.ID
+		}
 	}
 
-	pendingCerts, err := uc.certifications.Get(ctx, certifications.GetCertificationsRequest{UserID: request.UserID, Status: models.CertificationStatusPending})
+	createdCert, err := uc.certifications.Create(ctx, cert)
 	if err != nil {
 		return models.Certification{}, err
 	}
+	createdCert.User = user
+	return createdCert, nil
+}
 
-	if len(pendingCerts.Certifications) > 0 {
-		return models.Certification{}, contracts.ErrPendingCertsExists
+func (uc CertificationCreatorImpl) resubmit(ctx context.Context, cert models.Certification, user models.User) (models.Certification, error) {
+	transition := models.CertificationTransition{
+		FromStatus: cert.Status,
+		ToStatus:   models.CertificationStatusResubmitted,
+		ActorType:  models.CertificationActorUser,
+		ActorID:    user.ID,
+		CreatedAt:  time.Now(),
 	}
-
-	cert := models.Certification{Status: models.CertificationStatusPending, UserID: request.UserID}
-	createdCert, err := uc.certifications.Create(ctx, cert)
+	resubmittedCert, err := uc.certifications.Transition(ctx, cert, transition)
 	if err != nil {
 		return models.Certification{}, err
 	}
-	createdCert.User = user
-	return createdCert, nil
+	resubmittedCert.User = user
+	return resubmittedCert, nil
 }
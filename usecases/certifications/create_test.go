@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/fiufit/users/contracts"
 	certContracts "github.com/fiufit/users/contracts/certifications"
 	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/repositories/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func TestCreateCertification_ErrUserNotFound(t *testing.T) {
@@ -36,7 +39,7 @@ func TestCreateCertification_ErrUserAlreadyCertified(t *testing.T) {
 	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
 
 	users.On("GetByID", ctx, req.UserID).Return(models.User{}, nil)
-	certifications.On("Get", ctx, certContracts.GetCertificationsRequest{UserID: req.UserID, Status: models.CertificationStatusApproved}).Return(certContracts.GetCertificationsResponse{Certifications: []models.Certification{{}}}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(models.Certification{Status: models.CertificationStatusApproved}, nil)
 
 	_, err := creator.Create(ctx, req)
 
@@ -53,8 +56,7 @@ func TestCreateCertification_ErrPendingCertsExists(t *testing.T) {
 	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
 
 	users.On("GetByID", ctx, req.UserID).Return(models.User{}, nil)
-	certifications.On("Get", ctx, certContracts.GetCertificationsRequest{UserID: req.UserID, Status: models.CertificationStatusApproved}).Return(certContracts.GetCertificationsResponse{}, nil)
-	certifications.On("Get", ctx, certContracts.GetCertificationsRequest{UserID: req.UserID, Status: models.CertificationStatusPending}).Return(certContracts.GetCertificationsResponse{Certifications: []models.Certification{{}}}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(models.Certification{Status: models.CertificationStatusInReview}, nil)
 
 	_, err := creator.Create(ctx, req)
 
@@ -71,8 +73,7 @@ func TestCreateCertification_ErrCreateCertification(t *testing.T) {
 	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
 
 	users.On("GetByID", ctx, req.UserID).Return(models.User{}, nil)
-	certifications.On("Get", ctx, certContracts.GetCertificationsRequest{UserID: req.UserID, Status: models.CertificationStatusApproved}).Return(certContracts.GetCertificationsResponse{}, nil)
-	certifications.On("Get", ctx, certContracts.GetCertificationsRequest{UserID: req.UserID, Status: models.CertificationStatusPending}).Return(certContracts.GetCertificationsResponse{}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(models.Certification{}, contracts.ErrCertificationNotFound)
 	certifications.On("Create", ctx, models.Certification{Status: models.CertificationStatusPending, UserID: req.UserID}).Return(models.Certification{}, errors.New("error creating cert"))
 
 	_, err := creator.Create(ctx, req)
@@ -89,8 +90,7 @@ func TestCreateCertification_Ok(t *testing.T) {
 	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
 
 	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: req.UserID}, nil)
-	certifications.On("Get", ctx, certContracts.GetCertificationsRequest{UserID: req.UserID, Status: models.CertificationStatusApproved}).Return(certContracts.GetCertificationsResponse{}, nil)
-	certifications.On("Get", ctx, certContracts.GetCertificationsRequest{UserID: req.UserID, Status: models.CertificationStatusPending}).Return(certContracts.GetCertificationsResponse{}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(models.Certification{}, contracts.ErrCertificationNotFound)
 	certifications.On("Create", ctx, models.Certification{Status: models.CertificationStatusPending, UserID: req.UserID}).Return(models.Certification{Status: models.CertificationStatusPending}, nil)
 
 	createdCert, err := creator.Create(ctx, req)
@@ -99,3 +99,85 @@ func TestCreateCertification_Ok(t *testing.T) {
 	assert.Equal(t, createdCert.User.ID, req.UserID)
 	assert.Equal(t, createdCert.Status, models.CertificationStatusPending)
 }
+
+func TestCreateCertification_ResubmitsDeniedCertification(t *testing.T) {
+	users := new(mocks.Users)
+	certifications := new(mocks.Certifications)
+	creator := NewCertificationCreator(certifications, users)
+	ctx := context.Background()
+
+	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
+	deniedCert := models.Certification{Model: gorm.Model{ID: 3}, UserID: req.UserID, Status: models.CertificationStatusDenied}
+	resubmission := mock.MatchedBy(func(transition models.CertificationTransition) bool {
+		return transition.FromStatus == models.CertificationStatusDenied && transition.ToStatus == models.CertificationStatusResubmitted &&
+			transition.ActorType == models.CertificationActorUser && transition.ActorID == req.UserID
+	})
+
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: req.UserID}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(deniedCert, nil)
+	certifications.On("Transition", ctx, deniedCert, resubmission).Return(models.Certification{Model: gorm.Model{ID: 3}, Status: models.CertificationStatusResubmitted}, nil)
+
+	cert, err := creator.Create(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), cert.ID)
+	assert.Equal(t, models.CertificationStatusResubmitted, cert.Status)
+	certifications.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateCertification_AfterRevocationOk(t *testing.T) {
+	users := new(mocks.Users)
+	certifications := new(mocks.Certifications)
+	creator := NewCertificationCreator(certifications, users)
+	ctx := context.Background()
+
+	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
+
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: req.UserID}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(models.Certification{Status: models.CertificationStatusRevoked}, nil)
+	certifications.On("Create", ctx, models.Certification{Status: models.CertificationStatusPending, UserID: req.UserID}).Return(models.Certification{Status: models.CertificationStatusPending}, nil)
+
+	createdCert, err := creator.Create(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.CertificationStatusPending, createdCert.Status)
+}
+
+func TestCreateCertification_RenewalTooEarly(t *testing.T) {
+	users := new(mocks.Users)
+	certifications := new(mocks.Certifications)
+	creator := NewCertificationCreator(certifications, users)
+	ctx := context.Background()
+
+	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
+	expiresAt := time.Now().Add(models.CertificationRenewalWindow + 24*time.Hour)
+
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: req.UserID}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(models.Certification{Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt}, nil)
+
+	_, err := creator.Create(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserAlreadyCertified)
+}
+
+func TestCreateCertification_RenewalLinksPreviousCertification(t *testing.T) {
+	users := new(mocks.Users)
+	certifications := new(mocks.Certifications)
+	creator := NewCertificationCreator(certifications, users)
+	ctx := context.Background()
+
+	req := certContracts.CreateCertificationRequest{UserID: "pepe"}
+	expiresAt := time.Now().Add(24 * time.Hour)
+	renewal := mock.MatchedBy(func(cert models.Certification) bool {
+		return cert.Status == models.CertificationStatusPending && cert.RenewalOfID != nil && *cert.RenewalOfID == 3
+	})
+
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: req.UserID}, nil)
+	certifications.On("GetLatest", ctx, req.UserID).Return(models.Certification{Model: gorm.Model{ID: 3}, Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt}, nil)
+	certifications.On("Create", ctx, renewal).Return(func(ctx context.Context, cert models.Certification) models.Certification { return cert }, nil)
+
+	createdCert, err := creator.Create(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), *createdCert.RenewalOfID)
+}
@@ -0,0 +1,125 @@
+package certifications
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+	"github.com/fiufit/users/repositories/external"
+	"go.uber.org/zap"
+)
+
+type CertificationExpirer interface {
+	ExpireCertifications(ctx context.Context) (int, error)
+}
+
+type CertificationExpirerImpl struct {
+	certifications repositories.Certifications
+	users          repositories.Users
+	notifications  external.Notifications
+	logger         *zap.Logger
+}
+
+func NewCertificationExpirerImpl(certifications repositories.Certifications, users repositories.Users, notifications external.Notifications, logger *zap.Logger) CertificationExpirerImpl {
+	return CertificationExpirerImpl{certifications: certifications, users: users, notifications: notifications, logger: logger}
+}
+
+// ExpireCertifications reminds trainers whose certification is within its renewal window, and expires the
+// certifications past their validity. Trainers lose their verification unless a renewal was already approved. It
+// returns how many certifications expired.
+func (uc CertificationExpirerImpl) ExpireCertifications(ctx context.Context) (int, error) {
+	now := time.Now()
+	certs, err := uc.certifications.GetExpiring(ctx, now.Add(models.CertificationRenewalWindow))
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, cert := range certs {
+		if cert.ExpiresAt.After(now) {
+			if cert.ExpiryRemindedAt == nil {
+				if err := uc.remind(ctx, cert, now); err != nil {
+					return expired, err
+				}
+			}
+			continue
+		}
+
+		ok, err := uc.expire(ctx, cert, now)
+		if err != nil {
+			return expired, err
+		}
+		if ok {
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		uc.logger.Info("Expired certifications", zap.Int("certifications", expired))
+	}
+	return expired, nil
+}
+
+// remind notifies the trainer once, unless they already submitted a renewal. Failed notifications are retried on the
+// next run.
+func (uc CertificationExpirerImpl) remind(ctx context.Context, cert models.Certification, now time.Time) error {
+	latestCert, err := uc.certifications.GetLatest(ctx, cert.UserID)
+	if err != nil {
+		return err
+	}
+	if latestCert.ID == cert.ID {
+		if err := uc.notifications.SendCertificationExpiryReminder(ctx, cert); err != nil {
+			uc.logger.Error("Unable to send certification expiry reminder", zap.Error(err), zap.Any("cert", cert))
+			return nil
+		}
+	}
+	return uc.certifications.SetExpiryReminded(ctx, cert.ID, now)
+}
+
+// expire takes the verification away before expiring the certification, so a failed run is retried as a whole. A user
+// whose renewal was already approved stays verified.
+func (uc CertificationExpirerImpl) expire(ctx context.Context, cert models.Certification, now time.Time) (bool, error) {
+	latestCert, err := uc.certifications.GetLatest(ctx, cert.UserID)
+	if err != nil {
+		return false, err
+	}
+	renewed := latestCert.ID != cert.ID && latestCert.Status == models.CertificationStatusApproved
+
+	if !renewed {
+		user, err := uc.users.GetByID(ctx, cert.UserID)
+		if err != nil {
+			return false, err
+		}
+		if user.IsVerifiedTrainer {
+			user.IsVerifiedTrainer = false
+			if _, err := uc.users.Update(ctx, user); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	transition := models.CertificationTransition{
+		FromStatus: models.CertificationStatusApproved,
+		ToStatus:   models.CertificationStatusExpired,
+		ActorType:  models.CertificationActorSystem,
+		CreatedAt:  now,
+	}
+	expiredCert, err := uc.certifications.Transition(ctx, cert, transition)
+	if err != nil {
+		// revoked by an administrator since it was read
+		if errors.Is(err, contracts.ErrInvalidCertTransition) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if !renewed {
+		if err := uc.notifications.SendCertificationNotification(ctx, expiredCert); err != nil {
+			uc.logger.Error("Unable to send certification expiry notification", zap.Error(err), zap.Any("cert", expiredCert))
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,144 @@
+package certifications
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+	"gorm.io/gorm"
+)
+
+func TestExpireCertifications_GetExpiringError(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	notifications := new(mocks.Notifications)
+	expirer := NewCertificationExpirerImpl(certifications, users, notifications, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	certifications.On("GetExpiring", ctx, mock.AnythingOfType("time.Time")).Return(nil, errors.New("repo error"))
+	_, err := expirer.ExpireCertifications(ctx)
+
+	assert.Error(t, err)
+}
+
+func TestExpireCertifications_RemindsOnce(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	notifications := new(mocks.Notifications)
+	expirer := NewCertificationExpirerImpl(certifications, users, notifications, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(7 * 24 * time.Hour)
+	remindedAt := time.Now().Add(-24 * time.Hour)
+	expiring := models.Certification{Model: gorm.Model{ID: 1}, UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt}
+	reminded := models.Certification{Model: gorm.Model{ID: 2}, UserID: "b", Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt, ExpiryRemindedAt: &remindedAt}
+
+	certifications.On("GetExpiring", ctx, mock.AnythingOfType("time.Time")).Return([]models.Certification{expiring, reminded}, nil)
+	certifications.On("GetLatest", ctx, "a").Return(expiring, nil)
+	notifications.On("SendCertificationExpiryReminder", ctx, expiring).Return(nil)
+	certifications.On("SetExpiryReminded", ctx, uint(1), mock.AnythingOfType("time.Time")).Return(nil)
+	expired, err := expirer.ExpireCertifications(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, expired)
+	notifications.AssertNumberOfCalls(t, "SendCertificationExpiryReminder", 1)
+	certifications.AssertExpectations(t)
+}
+
+func TestExpireCertifications_SkipsReminderAfterRenewal(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	notifications := new(mocks.Notifications)
+	expirer := NewCertificationExpirerImpl(certifications, users, notifications, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(7 * 24 * time.Hour)
+	expiring := models.Certification{Model: gorm.Model{ID: 1}, UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt}
+
+	certifications.On("GetExpiring", ctx, mock.AnythingOfType("time.Time")).Return([]models.Certification{expiring}, nil)
+	certifications.On("GetLatest", ctx, "a").Return(models.Certification{Model: gorm.Model{ID: 2}, Status: models.CertificationStatusPending}, nil)
+	certifications.On("SetExpiryReminded", ctx, uint(1), mock.AnythingOfType("time.Time")).Return(nil)
+	_, err := expirer.ExpireCertifications(ctx)
+
+	assert.NoError(t, err)
+	notifications.AssertNotCalled(t, "SendCertificationExpiryReminder", mock.Anything, mock.Anything)
+}
+
+func TestExpireCertifications_DowngradesTrainer(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	notifications := new(mocks.Notifications)
+	expirer := NewCertificationExpirerImpl(certifications, users, notifications, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(-time.Hour)
+	lapsed := models.Certification{Model: gorm.Model{ID: 1}, UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt}
+	expiry := mock.MatchedBy(func(transition models.CertificationTransition) bool {
+		return transition.FromStatus == models.CertificationStatusApproved && transition.ToStatus == models.CertificationStatusExpired &&
+			transition.ActorType == models.CertificationActorSystem
+	})
+
+	certifications.On("GetExpiring", ctx, mock.AnythingOfType("time.Time")).Return([]models.Certification{lapsed}, nil)
+	certifications.On("GetLatest", ctx, "a").Return(lapsed, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a", IsVerifiedTrainer: true}, nil)
+	users.On("Update", ctx, models.User{ID: "a", IsVerifiedTrainer: false}).Return(models.User{ID: "a"}, nil)
+	certifications.On("Transition", ctx, lapsed, expiry).Return(models.Certification{UserID: "a", Status: models.CertificationStatusExpired}, nil)
+	notifications.On("SendCertificationNotification", ctx, models.Certification{UserID: "a", Status: models.CertificationStatusExpired}).Return(nil)
+	expired, err := expirer.ExpireCertifications(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	users.AssertExpectations(t)
+	notifications.AssertExpectations(t)
+}
+
+func TestExpireCertifications_KeepsRenewedTrainerVerified(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	notifications := new(mocks.Notifications)
+	expirer := NewCertificationExpirerImpl(certifications, users, notifications, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(-time.Hour)
+	lapsed := models.Certification{Model: gorm.Model{ID: 1}, UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt}
+	renewalOf := lapsed.ID
+	renewal := models.Certification{Model: gorm.Model{ID: 2}, UserID: "a", Status: models.CertificationStatusApproved, RenewalOfID: &renewalOf}
+
+	certifications.On("GetExpiring", ctx, mock.AnythingOfType("time.Time")).Return([]models.Certification{lapsed}, nil)
+	certifications.On("GetLatest", ctx, "a").Return(renewal, nil)
+	certifications.On("Transition", ctx, lapsed, mock.AnythingOfType("models.CertificationTransition")).Return(models.Certification{UserID: "a", Status: models.CertificationStatusExpired}, nil)
+	expired, err := expirer.ExpireCertifications(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	users.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	notifications.AssertNotCalled(t, "SendCertificationNotification", mock.Anything, mock.Anything)
+}
+
+func TestExpireCertifications_RevokedMeanwhile(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	notifications := new(mocks.Notifications)
+	expirer := NewCertificationExpirerImpl(certifications, users, notifications, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	expiresAt := time.Now().Add(-time.Hour)
+	lapsed := models.Certification{Model: gorm.Model{ID: 1}, UserID: "a", Status: models.CertificationStatusApproved, ExpiresAt: &expiresAt}
+
+	certifications.On("GetExpiring", ctx, mock.AnythingOfType("time.Time")).Return([]models.Certification{lapsed}, nil)
+	certifications.On("GetLatest", ctx, "a").Return(lapsed, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
+	certifications.On("Transition", ctx, lapsed, mock.AnythingOfType("models.CertificationTransition")).Return(models.Certification{}, contracts.ErrInvalidCertTransition)
+	expired, err := expirer.ExpireCertifications(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, expired)
+	users.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
@@ -2,6 +2,9 @@ package certifications
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"time"
 
 	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/certifications"
@@ -18,26 +21,30 @@ type CertificationUpdater interface {
 type CertificationUpdaterImpl struct {
 	certifications repositories.Certifications
 	users          repositories.Users
+	admins         repositories.Admins
 	notifications  external.Notifications
 	firebase       external.Firebase
 	logger         *zap.Logger
 }
 
-func NewCertificationUpdaterImpl(certifications repositories.Certifications, users repositories.Users, notifications external.Notifications, firebase external.Firebase, logger *zap.Logger) CertificationUpdaterImpl {
-	return CertificationUpdaterImpl{certifications: certifications, users: users, notifications: notifications, firebase: firebase, logger: logger}
+func NewCertificationUpdaterImpl(certifications repositories.Certifications, users repositories.Users, admins repositories.Admins, notifications external.Notifications, firebase external.Firebase, logger *zap.Logger) CertificationUpdaterImpl {
+	return CertificationUpdaterImpl{certifications: certifications, users: users, admins: admins, notifications: notifications, firebase: firebase, logger: logger}
 }
 
+// Update moves a certification along the review state machine on behalf of an administrator. Requesting the current
+// status again is a no-op. Revoking an approved certification takes the trainer verification away, unless another
+// approved certification is still valid, and its user can submit a new certification afterwards.
 func (uc CertificationUpdaterImpl) Update(ctx context.Context, req certifications.UpdateCertificationRequest) (models.Certification, error) {
 	cert, err := uc.certifications.GetByID(ctx, req.CertificationID)
 	if err != nil {
 		return models.Certification{}, err
 	}
 
-	if cert.Status == models.CertificationStatusApproved {
-		if req.Status == models.CertificationStatusApproved {
-			return cert, nil
-		}
-		return models.Certification{}, contracts.ErrUserAlreadyCertified
+	if cert.Status == req.Status {
+		return cert, nil
+	}
+	if !models.CanTransitionCertification(cert.Status, req.Status) {
+		return models.Certification{}, contracts.ErrInvalidCertTransition
 	}
 
 	user, err := uc.users.GetByID(ctx, cert.UserID)
@@ -45,22 +52,56 @@ func (uc CertificationUpdaterImpl) Update(ctx context.Context, req certification
 		return models.Certification{}, err
 	}
 
-	cert.Status = req.Status
-	updatedCert, err := uc.certifications.Update(ctx, cert)
+	_, err = uc.admins.GetByID(ctx, req.ReviewerID)
+	if err != nil {
+		if errors.Is(err, contracts.ErrUserNotFound) {
+			return models.Certification{}, contracts.ErrReviewerNotFound
+		}
+		return models.Certification{}, err
+	}
+
+	reviewedAt := time.Now()
+	cert.DenialReason = req.DenialReason
+	cert.RevocationReason = req.RevocationReason
+	cert.ReviewerNotes = req.Notes
+	cert.ReviewerID = &req.ReviewerID
+	cert.ReviewedAt = &reviewedAt
+	if req.ValidityDays > 0 {
+		expiresAt := reviewedAt.AddDate(0, 0, req.ValidityDays)
+		cert.ExpiresAt = &expiresAt
+	}
+	transition := models.CertificationTransition{
+		FromStatus: cert.Status,
+		ToStatus:   req.Status,
+		ActorType:  models.CertificationActorAdmin,
+		ActorID:    strconv.FormatUint(uint64(req.ReviewerID), 10),
+		CreatedAt:  reviewedAt,
+	}
+	updatedCert, err := uc.certifications.Transition(ctx, cert, transition)
 	if err != nil {
 		return models.Certification{}, err
 	}
 
-	if updatedCert.Status == models.CertificationStatusApproved {
-		user.IsVerifiedTrainer = true
+	// any other approved certification that hasn't expired, like a renewal or the certification it renewed, keeps the
+	// trainer verified
+	stillCertified := false
+	if updatedCert.Status == models.CertificationStatusRevoked {
+		stillCertified, err = uc.certifications.HasOtherValidApproved(ctx, updatedCert.UserID, updatedCert.ID, reviewedAt)
+		if err != nil {
+			return models.Certification{}, err
+		}
+	}
+
+	if (updatedCert.Status == models.CertificationStatusApproved || updatedCert.Status == models.CertificationStatusRevoked) && !stillCertified {
+		user.IsVerifiedTrainer = updatedCert.Status == models.CertificationStatusApproved
 		_, err := uc.users.Update(ctx, user)
 		if err != nil {
 			return models.Certification{}, err
 		}
 	}
 
-	if updatedCert.Status != models.CertificationStatusPending {
-		err = uc.notifications.SendCertificationNotification(ctx, user.ID, updatedCert.Status)
+	if updatedCert.Status != models.CertificationStatusInReview {
+		err = uc.notifications.SendCertificationNotification(ctx, updatedCert)
 		if err != nil {
 			uc.logger.Error("Unable to send Certification status update notification", zap.Error(err), zap.Any("cert", updatedCert))
 		}
@@ -10,21 +10,25 @@ import (
 	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/repositories/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap/zaptest"
+	"gorm.io/gorm"
 )
 
 func TestUpdateCertifications_GetCertError(t *testing.T) {
 	certifications := new(mocks.Certifications)
 	users := new(mocks.Users)
+	admins := new(mocks.Admins)
 	notifications := new(mocks.Notifications)
 	firebase := new(mocks.Firebase)
 	logger := zaptest.NewLogger(t)
-	certUpdater := NewCertificationUpdaterImpl(certifications, users, notifications, firebase, logger)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
 	ctx := context.Background()
 
 	req := certContracts.UpdateCertificationRequest{
 		CertificationID: 1,
 		Status:          "approved",
+		ReviewerID:      1,
 	}
 
 	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{}, contracts.ErrCertificationNotFound)
@@ -34,39 +38,44 @@ func TestUpdateCertifications_GetCertError(t *testing.T) {
 	assert.ErrorIs(t, err, contracts.ErrCertificationNotFound)
 }
 
-func TestUpdateCertifications_ErrUserAlreadyCertified(t *testing.T) {
+func TestUpdateCertifications_ErrInvalidTransition(t *testing.T) {
 	certifications := new(mocks.Certifications)
 	users := new(mocks.Users)
+	admins := new(mocks.Admins)
 	notifications := new(mocks.Notifications)
 	firebase := new(mocks.Firebase)
 	logger := zaptest.NewLogger(t)
-	certUpdater := NewCertificationUpdaterImpl(certifications, users, notifications, firebase, logger)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
 	ctx := context.Background()
 
 	req := certContracts.UpdateCertificationRequest{
 		CertificationID: 1,
 		Status:          "denied",
+		DenialReason:    models.CertificationDenialVideoUnclear,
+		ReviewerID:      1,
 	}
 
 	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{Status: models.CertificationStatusApproved}, nil)
 	_, err := certUpdater.Update(ctx, req)
 
 	assert.Error(t, err)
-	assert.ErrorIs(t, err, contracts.ErrUserAlreadyCertified)
+	assert.ErrorIs(t, err, contracts.ErrInvalidCertTransition)
 }
 
 func TestUpdateCertifications_UserAlreadyCertifiedOk(t *testing.T) {
 	certifications := new(mocks.Certifications)
 	users := new(mocks.Users)
+	admins := new(mocks.Admins)
 	notifications := new(mocks.Notifications)
 	firebase := new(mocks.Firebase)
 	logger := zaptest.NewLogger(t)
-	certUpdater := NewCertificationUpdaterImpl(certifications, users, notifications, firebase, logger)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
 	ctx := context.Background()
 
 	req := certContracts.UpdateCertificationRequest{
 		CertificationID: 1,
 		Status:          "approved",
+		ReviewerID:      1,
 	}
 
 	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{Status: models.CertificationStatusApproved}, nil)
@@ -78,18 +87,20 @@ func TestUpdateCertifications_UserAlreadyCertifiedOk(t *testing.T) {
 func TestUpdateCertifications_GetUserError(t *testing.T) {
 	certifications := new(mocks.Certifications)
 	users := new(mocks.Users)
+	admins := new(mocks.Admins)
 	notifications := new(mocks.Notifications)
 	firebase := new(mocks.Firebase)
 	logger := zaptest.NewLogger(t)
-	certUpdater := NewCertificationUpdaterImpl(certifications, users, notifications, firebase, logger)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
 	ctx := context.Background()
 
 	req := certContracts.UpdateCertificationRequest{
 		CertificationID: 1,
 		Status:          "approved",
+		ReviewerID:      1,
 	}
 
-	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a"}, nil)
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusInReview}, nil)
 	users.On("GetByID", ctx, "a").Return(models.User{}, contracts.ErrUserNotFound)
 	_, err := certUpdater.Update(ctx, req)
 
@@ -100,20 +111,23 @@ func TestUpdateCertifications_GetUserError(t *testing.T) {
 func TestUpdateCertifications_UpdateCertificationError(t *testing.T) {
 	certifications := new(mocks.Certifications)
 	users := new(mocks.Users)
+	admins := new(mocks.Admins)
 	notifications := new(mocks.Notifications)
 	firebase := new(mocks.Firebase)
 	logger := zaptest.NewLogger(t)
-	certUpdater := NewCertificationUpdaterImpl(certifications, users, notifications, firebase, logger)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
 	ctx := context.Background()
 
 	req := certContracts.UpdateCertificationRequest{
 		CertificationID: 1,
 		Status:          "approved",
+		ReviewerID:      1,
 	}
 
-	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a"}, nil)
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusInReview}, nil)
 	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
-	certifications.On("Update", ctx, models.Certification{UserID: "a", Status: req.Status}).Return(models.Certification{}, errors.New("repo update error"))
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, mock.AnythingOfType("models.Certification"), mock.AnythingOfType("models.CertificationTransition")).Return(models.Certification{}, errors.New("repo update error"))
 	_, err := certUpdater.Update(ctx, req)
 
 	assert.Error(t, err)
@@ -122,20 +136,23 @@ func TestUpdateCertifications_UpdateCertificationError(t *testing.T) {
 func TestUpdateCertification_UpdateUserError(t *testing.T) {
 	certifications := new(mocks.Certifications)
 	users := new(mocks.Users)
+	admins := new(mocks.Admins)
 	notifications := new(mocks.Notifications)
 	firebase := new(mocks.Firebase)
 	logger := zaptest.NewLogger(t)
-	certUpdater := NewCertificationUpdaterImpl(certifications, users, notifications, firebase, logger)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
 	ctx := context.Background()
 
 	req := certContracts.UpdateCertificationRequest{
 		CertificationID: 1,
 		Status:          "approved",
+		ReviewerID:      1,
 	}
 
-	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a"}, nil)
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusInReview}, nil)
 	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
-	certifications.On("Update", ctx, models.Certification{UserID: "a", Status: req.Status}).Return(models.Certification{UserID: "a", Status: req.Status}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, mock.AnythingOfType("models.Certification"), mock.AnythingOfType("models.CertificationTransition")).Return(models.Certification{UserID: "a", Status: req.Status}, nil)
 	users.On("Update", ctx, models.User{ID: "a", IsVerifiedTrainer: true}).Return(models.User{}, errors.New("repo update error"))
 	_, err := certUpdater.Update(ctx, req)
 
@@ -145,24 +162,260 @@ func TestUpdateCertification_UpdateUserError(t *testing.T) {
 func TestUpdateCertification_Ok(t *testing.T) {
 	certifications := new(mocks.Certifications)
 	users := new(mocks.Users)
+	admins := new(mocks.Admins)
 	notifications := new(mocks.Notifications)
 	firebase := new(mocks.Firebase)
 	logger := zaptest.NewLogger(t)
-	certUpdater := NewCertificationUpdaterImpl(certifications, users, notifications, firebase, logger)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
 	ctx := context.Background()
 
 	req := certContracts.UpdateCertificationRequest{
 		CertificationID: 1,
 		Status:          "approved",
+		ReviewerID:      1,
 	}
 
-	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a"}, nil)
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusInReview}, nil)
 	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
-	certifications.On("Update", ctx, models.Certification{UserID: "a", Status: req.Status}).Return(models.Certification{UserID: "a", Status: req.Status}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, mock.AnythingOfType("models.Certification"), mock.AnythingOfType("models.CertificationTransition")).Return(models.Certification{UserID: "a", Status: req.Status}, nil)
 	users.On("Update", ctx, models.User{ID: "a", IsVerifiedTrainer: true}).Return(models.User{}, nil)
-	notifications.On("SendCertificationNotification", ctx, "a", req.Status).Return(nil)
+	notifications.On("SendCertificationNotification", ctx, mock.AnythingOfType("models.Certification")).Return(nil)
 	firebase.On("GetCertificationVideoUrl", ctx, "a").Return("url", nil)
 	_, err := certUpdater.Update(ctx, req)
 
 	assert.NoError(t, err)
 }
+
+func TestUpdateCertification_ReviewerNotFound(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	admins := new(mocks.Admins)
+	notifications := new(mocks.Notifications)
+	firebase := new(mocks.Firebase)
+	logger := zaptest.NewLogger(t)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
+	ctx := context.Background()
+
+	req := certContracts.UpdateCertificationRequest{
+		CertificationID: 1,
+		Status:          "denied",
+		DenialReason:    models.CertificationDenialVideoUnclear,
+		ReviewerID:      7,
+	}
+
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusInReview}, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, contracts.ErrUserNotFound)
+	_, err := certUpdater.Update(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrReviewerNotFound)
+}
+
+func TestUpdateCertification_DeniedRecordsReview(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	admins := new(mocks.Admins)
+	notifications := new(mocks.Notifications)
+	firebase := new(mocks.Firebase)
+	logger := zaptest.NewLogger(t)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
+	ctx := context.Background()
+
+	req := certContracts.UpdateCertificationRequest{
+		CertificationID: 1,
+		Status:          "denied",
+		DenialReason:    models.CertificationDenialOther,
+		Notes:           "Please show your diploma",
+		ReviewerID:      7,
+	}
+
+	reviewed := mock.MatchedBy(func(cert models.Certification) bool {
+		return cert.DenialReason == req.DenialReason && cert.ReviewerNotes == req.Notes &&
+			cert.ReviewerID != nil && *cert.ReviewerID == req.ReviewerID && cert.ReviewedAt != nil
+	})
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusInReview}, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, reviewed, mock.AnythingOfType("models.CertificationTransition")).Return(func(ctx context.Context, cert models.Certification, transition models.CertificationTransition) models.Certification {
+		cert.Status = transition.ToStatus
+		return cert
+	}, nil)
+	notifications.On("SendCertificationNotification", ctx, mock.MatchedBy(func(cert models.Certification) bool {
+		return cert.Status == req.Status && cert.DenialReason == req.DenialReason
+	})).Return(nil)
+	firebase.On("GetCertificationVideoUrl", ctx, "a").Return("url")
+	cert, err := certUpdater.Update(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, req.Notes, cert.ReviewerNotes)
+	notifications.AssertExpectations(t)
+}
+
+func TestUpdateCertification_InvalidTransitionFromPending(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	admins := new(mocks.Admins)
+	notifications := new(mocks.Notifications)
+	firebase := new(mocks.Firebase)
+	logger := zaptest.NewLogger(t)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
+	ctx := context.Background()
+
+	req := certContracts.UpdateCertificationRequest{
+		CertificationID: 1,
+		Status:          "approved",
+		ReviewerID:      1,
+	}
+
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusPending}, nil)
+	_, err := certUpdater.Update(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrInvalidCertTransition)
+	certifications.AssertNotCalled(t, "Transition", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateCertification_StartReviewRecordsTransition(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	admins := new(mocks.Admins)
+	notifications := new(mocks.Notifications)
+	firebase := new(mocks.Firebase)
+	logger := zaptest.NewLogger(t)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
+	ctx := context.Background()
+
+	req := certContracts.UpdateCertificationRequest{
+		CertificationID: 1,
+		Status:          "in_review",
+		ReviewerID:      7,
+	}
+
+	transition := mock.MatchedBy(func(transition models.CertificationTransition) bool {
+		return transition.FromStatus == models.CertificationStatusPending && transition.ToStatus == models.CertificationStatusInReview &&
+			transition.ActorType == models.CertificationActorAdmin && transition.ActorID == "7"
+	})
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusPending}, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, mock.AnythingOfType("models.Certification"), transition).Return(models.Certification{UserID: "a", Status: req.Status}, nil)
+	firebase.On("GetCertificationVideoUrl", ctx, "a").Return("url")
+	cert, err := certUpdater.Update(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.CertificationStatusInReview, cert.Status)
+	users.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	notifications.AssertNotCalled(t, "SendCertificationNotification", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCertification_RevokeClearsVerification(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	admins := new(mocks.Admins)
+	notifications := new(mocks.Notifications)
+	firebase := new(mocks.Firebase)
+	logger := zaptest.NewLogger(t)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
+	ctx := context.Background()
+
+	req := certContracts.UpdateCertificationRequest{
+		CertificationID:  1,
+		Status:           "revoked",
+		RevocationReason: models.CertificationRevocationExpiredCredentials,
+		ReviewerID:       7,
+	}
+
+	revoked := mock.MatchedBy(func(cert models.Certification) bool {
+		return cert.Status == models.CertificationStatusRevoked && cert.RevocationReason == req.RevocationReason
+	})
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{Model: gorm.Model{ID: 1}, UserID: "a", Status: models.CertificationStatusApproved}, nil)
+	certifications.On("HasOtherValidApproved", ctx, "a", uint(1), mock.AnythingOfType("time.Time")).Return(false, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a", IsVerifiedTrainer: true}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, mock.AnythingOfType("models.Certification"), mock.AnythingOfType("models.CertificationTransition")).Return(func(ctx context.Context, cert models.Certification, transition models.CertificationTransition) models.Certification {
+		cert.Status = transition.ToStatus
+		return cert
+	}, nil)
+	users.On("Update", ctx, models.User{ID: "a", IsVerifiedTrainer: false}).Return(models.User{ID: "a"}, nil)
+	notifications.On("SendCertificationNotification", ctx, revoked).Return(nil)
+	firebase.On("GetCertificationVideoUrl", ctx, "a").Return("url")
+	cert, err := certUpdater.Update(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.CertificationStatusRevoked, cert.Status)
+	assert.False(t, cert.User.IsVerifiedTrainer)
+	users.AssertExpectations(t)
+	notifications.AssertExpectations(t)
+}
+
+func TestUpdateCertification_RevokeRenewalKeepsVerificationWithValidOriginal(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	admins := new(mocks.Admins)
+	notifications := new(mocks.Notifications)
+	firebase := new(mocks.Firebase)
+	logger := zaptest.NewLogger(t)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
+	ctx := context.Background()
+	originalID := uint(1)
+
+	req := certContracts.UpdateCertificationRequest{
+		CertificationID:  2,
+		Status:           "revoked",
+		RevocationReason: models.CertificationRevocationExpiredCredentials,
+		ReviewerID:       7,
+	}
+
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{Model: gorm.Model{ID: 2}, UserID: "a", Status: models.CertificationStatusApproved, RenewalOfID: &originalID}, nil)
+	certifications.On("HasOtherValidApproved", ctx, "a", uint(2), mock.AnythingOfType("time.Time")).Return(true, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a", IsVerifiedTrainer: true}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, mock.AnythingOfType("models.Certification"), mock.AnythingOfType("models.CertificationTransition")).Return(func(ctx context.Context, cert models.Certification, transition models.CertificationTransition) models.Certification {
+		cert.Status = transition.ToStatus
+		return cert
+	}, nil)
+	notifications.On("SendCertificationNotification", ctx, mock.AnythingOfType("models.Certification")).Return(nil)
+	firebase.On("GetCertificationVideoUrl", ctx, "a").Return("url")
+	cert, err := certUpdater.Update(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.CertificationStatusRevoked, cert.Status)
+	assert.True(t, cert.User.IsVerifiedTrainer)
+	users.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCertification_ApprovalSetsExpiry(t *testing.T) {
+	certifications := new(mocks.Certifications)
+	users := new(mocks.Users)
+	admins := new(mocks.Admins)
+	notifications := new(mocks.Notifications)
+	firebase := new(mocks.Firebase)
+	logger := zaptest.NewLogger(t)
+	certUpdater := NewCertificationUpdaterImpl(certifications, users, admins, notifications, firebase, logger)
+	ctx := context.Background()
+
+	req := certContracts.UpdateCertificationRequest{
+		CertificationID: 1,
+		Status:          "approved",
+		ReviewerID:      1,
+		ValidityDays:    365,
+	}
+
+	withExpiry := mock.MatchedBy(func(cert models.Certification) bool {
+		return cert.ExpiresAt != nil && cert.ReviewedAt != nil && cert.ExpiresAt.Equal(cert.ReviewedAt.AddDate(0, 0, req.ValidityDays))
+	})
+	certifications.On("GetByID", ctx, req.CertificationID).Return(models.Certification{UserID: "a", Status: models.CertificationStatusInReview}, nil)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
+	admins.On("GetByID", ctx, req.ReviewerID).Return(models.Administrator{}, nil)
+	certifications.On("Transition", ctx, withExpiry, mock.AnythingOfType("models.CertificationTransition")).Return(func(ctx context.Context, cert models.Certification, transition models.CertificationTransition) models.Certification {
+		cert.Status = transition.ToStatus
+		return cert
+	}, nil)
+	users.On("Update", ctx, models.User{ID: "a", IsVerifiedTrainer: true}).Return(models.User{}, nil)
+	notifications.On("SendCertificationNotification", ctx, mock.AnythingOfType("models.Certification")).Return(nil)
+	firebase.On("GetCertificationVideoUrl", ctx, "a").Return("url")
+	cert, err := certUpdater.Update(ctx, req)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, cert.ExpiresAt)
+}
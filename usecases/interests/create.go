@@ -0,0 +1,40 @@
+package interests
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+)
+
+type InterestCreator interface {
+	Create(ctx context.Context, req interests.CreateInterestRequest) (models.Interest, error)
+}
+
+type InterestCreatorImpl struct {
+	interests repositories.Interests
+}
+
+func NewInterestCreatorImpl(interests repositories.Interests) InterestCreatorImpl {
+	return InterestCreatorImpl{interests: interests}
+}
+
+func (uc InterestCreatorImpl) Create(ctx context.Context, req interests.CreateInterestRequest) (models.Interest, error) {
+	interest := models.Interest{
+		Name:        interests.NormalizeName(req.Name),
+		DisplayName: req.DisplayName,
+		IconKey:     req.IconKey,
+		Active:      true,
+	}
+
+	for language, displayName := range req.Translations {
+		interest.Translations = append(interest.Translations, models.InterestTranslation{
+			InterestName: interest.Name,
+			Language:     language,
+			DisplayName:  displayName,
+		})
+	}
+
+	return uc.interests.Create(ctx, interest)
+}
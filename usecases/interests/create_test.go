@@ -0,0 +1,39 @@
+package interests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	iContracts "github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterestCreatorImpl_Create_AlreadyExists(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestCreatorImpl(interests)
+	ctx := context.Background()
+	req := iContracts.CreateInterestRequest{Name: "yoga", DisplayName: "Yoga"}
+	expected := models.Interest{Name: "yoga", DisplayName: "Yoga", Active: true}
+	interests.On("Create", ctx, expected).Return(models.Interest{}, contracts.ErrInterestAlreadyExists)
+
+	_, err := uc.Create(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrInterestAlreadyExists)
+}
+
+func TestInterestCreatorImpl_Create_NormalizesNameOk(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestCreatorImpl(interests)
+	ctx := context.Background()
+	req := iContracts.CreateInterestRequest{Name: "  Yoga ", DisplayName: "Yoga", IconKey: "yoga"}
+	expected := models.Interest{Name: "yoga", DisplayName: "Yoga", IconKey: "yoga", Active: true}
+	interests.On("Create", ctx, expected).Return(expected, nil)
+
+	created, err := uc.Create(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, created)
+}
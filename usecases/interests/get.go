@@ -0,0 +1,24 @@
+package interests
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/repositories"
+)
+
+type InterestGetter interface {
+	Get(ctx context.Context, req interests.GetInterestsRequest) (interests.GetInterestsResponse, error)
+}
+
+type InterestGetterImpl struct {
+	interests repositories.Interests
+}
+
+func NewInterestGetterImpl(interests repositories.Interests) InterestGetterImpl {
+	return InterestGetterImpl{interests: interests}
+}
+
+func (uc InterestGetterImpl) Get(ctx context.Context, req interests.GetInterestsRequest) (interests.GetInterestsResponse, error) {
+	return uc.interests.Get(ctx, req)
+}
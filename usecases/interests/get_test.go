@@ -0,0 +1,38 @@
+package interests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	iContracts "github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterestGetterImpl_Get_Error(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestGetterImpl(interests)
+	ctx := context.Background()
+	req := iContracts.GetInterestsRequest{}
+	interests.On("Get", ctx, req).Return(iContracts.GetInterestsResponse{}, errors.New("repo error"))
+
+	_, err := uc.Get(ctx, req)
+
+	assert.Error(t, err)
+}
+
+func TestInterestGetterImpl_Get_Ok(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestGetterImpl(interests)
+	ctx := context.Background()
+	req := iContracts.GetInterestsRequest{IncludeInactive: true}
+	expected := iContracts.GetInterestsResponse{Interests: []models.Interest{{Name: "yoga"}}}
+	interests.On("Get", ctx, req).Return(expected, nil)
+
+	res, err := uc.Get(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, res)
+}
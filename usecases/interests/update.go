@@ -0,0 +1,67 @@
+package interests
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+)
+
+type InterestUpdater interface {
+	Update(ctx context.Context, req interests.UpdateInterestRequest) (models.Interest, error)
+	Deactivate(ctx context.Context, name string) (models.Interest, error)
+}
+
+type InterestUpdaterImpl struct {
+	interests repositories.Interests
+}
+
+func NewInterestUpdaterImpl(interests repositories.Interests) InterestUpdaterImpl {
+	return InterestUpdaterImpl{interests: interests}
+}
+
+func (uc InterestUpdaterImpl) Update(ctx context.Context, req interests.UpdateInterestRequest) (models.Interest, error) {
+	interest, err := uc.interests.GetByName(ctx, interests.NormalizeName(req.Name))
+	if err != nil {
+		return models.Interest{}, err
+	}
+
+	if req.DisplayName != nil && *req.DisplayName != "" {
+		interest.DisplayName = *req.DisplayName
+	}
+
+	if req.IconKey != nil {
+		interest.IconKey = *req.IconKey
+	}
+
+	if req.Active != nil {
+		interest.Active = *req.Active
+	}
+
+	for language, displayName := range req.Translations {
+		upsertTranslation(&interest, language, displayName)
+	}
+
+	return uc.interests.Update(ctx, interest)
+}
+
+// Deactivate hides an interest from the catalog without removing it from the users that already picked it.
+func (uc InterestUpdaterImpl) Deactivate(ctx context.Context, name string) (models.Interest, error) {
+	active := false
+	return uc.Update(ctx, interests.UpdateInterestRequest{Name: name, Active: &active})
+}
+
+func upsertTranslation(interest *models.Interest, language string, displayName string) {
+	for i := range interest.Translations {
+		if interest.Translations[i].Language == language {
+			interest.Translations[i].DisplayName = displayName
+			return
+		}
+	}
+	interest.Translations = append(interest.Translations, models.InterestTranslation{
+		InterestName: interest.Name,
+		Language:     language,
+		DisplayName:  displayName,
+	})
+}
@@ -0,0 +1,79 @@
+package interests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	iContracts "github.com/fiufit/users/contracts/interests"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterestUpdaterImpl_Update_NotFound(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestUpdaterImpl(interests)
+	ctx := context.Background()
+	interests.On("GetByName", ctx, "yoga").Return(models.Interest{}, contracts.ErrInterestNotFound)
+
+	_, err := uc.Update(ctx, iContracts.UpdateInterestRequest{Name: "Yoga"})
+
+	assert.ErrorIs(t, err, contracts.ErrInterestNotFound)
+}
+
+func TestInterestUpdaterImpl_Update_Ok(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestUpdaterImpl(interests)
+	ctx := context.Background()
+	displayName := "Yoga & Pilates"
+	iconKey := "lotus"
+	stored := models.Interest{Name: "yoga", DisplayName: "Yoga", IconKey: "yoga", Active: true}
+	expected := models.Interest{Name: "yoga", DisplayName: displayName, IconKey: iconKey, Active: true}
+	interests.On("GetByName", ctx, "yoga").Return(stored, nil)
+	interests.On("Update", ctx, expected).Return(expected, nil)
+
+	updated, err := uc.Update(ctx, iContracts.UpdateInterestRequest{Name: "yoga", DisplayName: &displayName, IconKey: &iconKey})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
+
+func TestInterestUpdaterImpl_Deactivate_Ok(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestUpdaterImpl(interests)
+	ctx := context.Background()
+	stored := models.Interest{Name: "yoga", DisplayName: "Yoga", Active: true}
+	expected := models.Interest{Name: "yoga", DisplayName: "Yoga", Active: false}
+	interests.On("GetByName", ctx, "yoga").Return(stored, nil)
+	interests.On("Update", ctx, expected).Return(expected, nil)
+
+	updated, err := uc.Deactivate(ctx, "yoga")
+
+	assert.NoError(t, err)
+	assert.False(t, updated.Active)
+}
+
+func TestInterestUpdaterImpl_Update_UpsertsTranslationsOk(t *testing.T) {
+	interests := new(mocks.Interests)
+	uc := NewInterestUpdaterImpl(interests)
+	ctx := context.Background()
+	stored := models.Interest{
+		Name:         "yoga",
+		DisplayName:  "Yoga",
+		Active:       true,
+		Translations: []models.InterestTranslation{{InterestName: "yoga", Language: models.LanguageSpanish, DisplayName: "Yogi"}},
+	}
+	expected := models.Interest{
+		Name:         "yoga",
+		DisplayName:  "Yoga",
+		Active:       true,
+		Translations: []models.InterestTranslation{{InterestName: "yoga", Language: models.LanguageSpanish, DisplayName: "Yoga"}},
+	}
+	interests.On("GetByName", ctx, "yoga").Return(stored, nil)
+	interests.On("Update", ctx, expected).Return(expected, nil)
+
+	_, err := uc.Update(ctx, iContracts.UpdateInterestRequest{Name: "yoga", Translations: map[string]string{models.LanguageSpanish: "Yoga"}})
+
+	assert.NoError(t, err)
+}
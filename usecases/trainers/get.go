@@ -0,0 +1,36 @@
+package trainers
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+)
+
+type TrainerProfileGetter interface {
+	Get(ctx context.Context, req trainers.GetTrainerProfileRequest) (models.TrainerProfile, error)
+}
+
+type TrainerProfileGetterImpl struct {
+	profiles repositories.TrainerProfiles
+	users    repositories.Users
+}
+
+func NewTrainerProfileGetterImpl(profiles repositories.TrainerProfiles, users repositories.Users) TrainerProfileGetterImpl {
+	return TrainerProfileGetterImpl{profiles: profiles, users: users}
+}
+
+func (uc TrainerProfileGetterImpl) Get(ctx context.Context, req trainers.GetTrainerProfileRequest) (models.TrainerProfile, error) {
+	user, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return models.TrainerProfile{}, err
+	}
+
+	if !user.IsVerifiedTrainer {
+		return models.TrainerProfile{}, contracts.ErrTrainerProfileNotFound
+	}
+
+	return uc.profiles.GetByUserID(ctx, req.UserID)
+}
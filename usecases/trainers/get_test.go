@@ -0,0 +1,50 @@
+package trainers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrainerProfileGetterImpl_Get_UserNotFound(t *testing.T) {
+	profiles := new(mocks.TrainerProfiles)
+	users := new(mocks.Users)
+	uc := NewTrainerProfileGetterImpl(profiles, users)
+	ctx := context.Background()
+	users.On("GetByID", ctx, "a").Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := uc.Get(ctx, trainers.GetTrainerProfileRequest{UserID: "a"})
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestTrainerProfileGetterImpl_Get_NotVerifiedTrainer(t *testing.T) {
+	profiles := new(mocks.TrainerProfiles)
+	users := new(mocks.Users)
+	uc := NewTrainerProfileGetterImpl(profiles, users)
+	ctx := context.Background()
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
+
+	_, err := uc.Get(ctx, trainers.GetTrainerProfileRequest{UserID: "a"})
+
+	assert.ErrorIs(t, err, contracts.ErrTrainerProfileNotFound)
+}
+
+func TestTrainerProfileGetterImpl_Get_Ok(t *testing.T) {
+	profiles := new(mocks.TrainerProfiles)
+	users := new(mocks.Users)
+	uc := NewTrainerProfileGetterImpl(profiles, users)
+	ctx := context.Background()
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a", IsVerifiedTrainer: true}, nil)
+	profiles.On("GetByUserID", ctx, "a").Return(models.TrainerProfile{UserID: "a", Bio: "bio"}, nil)
+
+	profile, err := uc.Get(ctx, trainers.GetTrainerProfileRequest{UserID: "a"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "bio", profile.Bio)
+}
@@ -0,0 +1,83 @@
+package trainers
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+)
+
+type TrainerReviewer interface {
+	Create(ctx context.Context, req trainers.CreateReviewRequest) (models.TrainerReview, error)
+	Update(ctx context.Context, req trainers.UpdateReviewRequest) (models.TrainerReview, error)
+	Get(ctx context.Context, req trainers.GetReviewsRequest) (trainers.GetReviewsResponse, error)
+	Delete(ctx context.Context, reviewID uint) error
+}
+
+type TrainerReviewerImpl struct {
+	reviews repositories.TrainerReviews
+	users   repositories.Users
+}
+
+func NewTrainerReviewerImpl(reviews repositories.TrainerReviews, users repositories.Users) TrainerReviewerImpl {
+	return TrainerReviewerImpl{reviews: reviews, users: users}
+}
+
+// Create reviews a verified trainer on behalf of one of their followers.
+func (uc TrainerReviewerImpl) Create(ctx context.Context, req trainers.CreateReviewRequest) (models.TrainerReview, error) {
+	trainer, err := uc.users.GetByID(ctx, req.TrainerID)
+	if err != nil {
+		return models.TrainerReview{}, err
+	}
+
+	if !trainer.IsVerifiedTrainer {
+		return models.TrainerReview{}, contracts.ErrUserNotVerifiedTrainer
+	}
+
+	relationships, err := uc.users.GetRelationships(ctx, req.ReviewerID, []string{req.TrainerID})
+	if err != nil {
+		return models.TrainerReview{}, err
+	}
+	if len(relationships) == 0 || !relationships[0].Follows {
+		return models.TrainerReview{}, contracts.ErrReviewerNotFollowing
+	}
+
+	review := models.TrainerReview{
+		TrainerID:  req.TrainerID,
+		ReviewerID: req.ReviewerID,
+		Rating:     req.Rating,
+		Review:     req.Review,
+	}
+	return uc.reviews.Create(ctx, review)
+}
+
+func (uc TrainerReviewerImpl) Update(ctx context.Context, req trainers.UpdateReviewRequest) (models.TrainerReview, error) {
+	review, err := uc.reviews.GetByReviewer(ctx, req.TrainerID, req.ReviewerID)
+	if err != nil {
+		return models.TrainerReview{}, err
+	}
+
+	if req.Rating != nil {
+		review.Rating = *req.Rating
+	}
+	if req.Review != nil {
+		review.Review = *req.Review
+	}
+	return uc.reviews.Update(ctx, review)
+}
+
+func (uc TrainerReviewerImpl) Get(ctx context.Context, req trainers.GetReviewsRequest) (trainers.GetReviewsResponse, error) {
+	_, err := uc.users.GetByID(ctx, req.TrainerID)
+	if err != nil {
+		return trainers.GetReviewsResponse{}, err
+	}
+
+	return uc.reviews.Get(ctx, req)
+}
+
+// Delete removes a review, it's meant for admin moderation.
+func (uc TrainerReviewerImpl) Delete(ctx context.Context, reviewID uint) error {
+	return uc.reviews.Delete(ctx, reviewID)
+}
@@ -0,0 +1,118 @@
+package trainers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	uContracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrainerReviewerImpl_Create_NotVerifiedTrainer(t *testing.T) {
+	reviews := new(mocks.TrainerReviews)
+	users := new(mocks.Users)
+	uc := NewTrainerReviewerImpl(reviews, users)
+	ctx := context.Background()
+	req := trainers.CreateReviewRequest{TrainerID: "trainer", ReviewerID: "reviewer", Rating: 5}
+	users.On("GetByID", ctx, req.TrainerID).Return(models.User{ID: req.TrainerID}, nil)
+
+	_, err := uc.Create(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotVerifiedTrainer)
+}
+
+func TestTrainerReviewerImpl_Create_NotFollowing(t *testing.T) {
+	reviews := new(mocks.TrainerReviews)
+	users := new(mocks.Users)
+	uc := NewTrainerReviewerImpl(reviews, users)
+	ctx := context.Background()
+	req := trainers.CreateReviewRequest{TrainerID: "trainer", ReviewerID: "reviewer", Rating: 5}
+	users.On("GetByID", ctx, req.TrainerID).Return(models.User{ID: req.TrainerID, IsVerifiedTrainer: true}, nil)
+	users.On("GetRelationships", ctx, req.ReviewerID, []string{req.TrainerID}).Return([]uContracts.Relationship{{UserID: req.TrainerID, FollowedBy: true}}, nil)
+
+	_, err := uc.Create(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrReviewerNotFollowing)
+	reviews.AssertNotCalled(t, "Create")
+}
+
+func TestTrainerReviewerImpl_Create_Ok(t *testing.T) {
+	reviews := new(mocks.TrainerReviews)
+	users := new(mocks.Users)
+	uc := NewTrainerReviewerImpl(reviews, users)
+	ctx := context.Background()
+	req := trainers.CreateReviewRequest{TrainerID: "trainer", ReviewerID: "reviewer", Rating: 4, Review: "great"}
+	review := models.TrainerReview{TrainerID: req.TrainerID, ReviewerID: req.ReviewerID, Rating: req.Rating, Review: req.Review}
+	users.On("GetByID", ctx, req.TrainerID).Return(models.User{ID: req.TrainerID, IsVerifiedTrainer: true}, nil)
+	users.On("GetRelationships", ctx, req.ReviewerID, []string{req.TrainerID}).Return([]uContracts.Relationship{{UserID: req.TrainerID, Follows: true}}, nil)
+	reviews.On("Create", ctx, review).Return(review, nil)
+
+	created, err := uc.Create(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, review, created)
+}
+
+func TestTrainerReviewerImpl_Update_NotFound(t *testing.T) {
+	reviews := new(mocks.TrainerReviews)
+	users := new(mocks.Users)
+	uc := NewTrainerReviewerImpl(reviews, users)
+	ctx := context.Background()
+	req := trainers.UpdateReviewRequest{TrainerID: "trainer", ReviewerID: "reviewer"}
+	reviews.On("GetByReviewer", ctx, req.TrainerID, req.ReviewerID).Return(models.TrainerReview{}, contracts.ErrReviewNotFound)
+
+	_, err := uc.Update(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrReviewNotFound)
+}
+
+func TestTrainerReviewerImpl_Update_Ok(t *testing.T) {
+	reviews := new(mocks.TrainerReviews)
+	users := new(mocks.Users)
+	uc := NewTrainerReviewerImpl(reviews, users)
+	ctx := context.Background()
+	rating := uint(2)
+	req := trainers.UpdateReviewRequest{TrainerID: "trainer", ReviewerID: "reviewer", Rating: &rating}
+	review := models.TrainerReview{ID: 1, TrainerID: "trainer", ReviewerID: "reviewer", Rating: 5, Review: "great"}
+	updated := review
+	updated.Rating = rating
+	reviews.On("GetByReviewer", ctx, req.TrainerID, req.ReviewerID).Return(review, nil)
+	reviews.On("Update", ctx, updated).Return(updated, nil)
+
+	res, err := uc.Update(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rating, res.Rating)
+	assert.Equal(t, "great", res.Review)
+}
+
+func TestTrainerReviewerImpl_Get_RepoError(t *testing.T) {
+	reviews := new(mocks.TrainerReviews)
+	users := new(mocks.Users)
+	uc := NewTrainerReviewerImpl(reviews, users)
+	ctx := context.Background()
+	req := trainers.GetReviewsRequest{TrainerID: "trainer"}
+	users.On("GetByID", ctx, req.TrainerID).Return(models.User{}, nil)
+	reviews.On("Get", ctx, req).Return(trainers.GetReviewsResponse{}, errors.New("repo error"))
+
+	_, err := uc.Get(ctx, req)
+
+	assert.Error(t, err)
+}
+
+func TestTrainerReviewerImpl_Delete_NotFound(t *testing.T) {
+	reviews := new(mocks.TrainerReviews)
+	users := new(mocks.Users)
+	uc := NewTrainerReviewerImpl(reviews, users)
+	ctx := context.Background()
+	reviews.On("Delete", ctx, uint(1)).Return(contracts.ErrReviewNotFound)
+
+	err := uc.Delete(ctx, 1)
+
+	assert.ErrorIs(t, err, contracts.ErrReviewNotFound)
+}
@@ -0,0 +1,52 @@
+package trainers
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+)
+
+type TrainerProfileUpdater interface {
+	Update(ctx context.Context, req trainers.UpdateTrainerProfileRequest) (models.TrainerProfile, error)
+}
+
+type TrainerProfileUpdaterImpl struct {
+	profiles repositories.TrainerProfiles
+	users    repositories.Users
+}
+
+func NewTrainerProfileUpdaterImpl(profiles repositories.TrainerProfiles, users repositories.Users) TrainerProfileUpdaterImpl {
+	return TrainerProfileUpdaterImpl{profiles: profiles, users: users}
+}
+
+// Update replaces the trainer profile of req.UserID. Only users with an approved certification have one.
+func (uc TrainerProfileUpdaterImpl) Update(ctx context.Context, req trainers.UpdateTrainerProfileRequest) (models.TrainerProfile, error) {
+	user, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return models.TrainerProfile{}, err
+	}
+
+	if !user.IsVerifiedTrainer {
+		return models.TrainerProfile{}, contracts.ErrUserNotVerifiedTrainer
+	}
+
+	profile := models.TrainerProfile{
+		UserID:            req.UserID,
+		Bio:               req.Bio,
+		YearsOfExperience: req.YearsOfExperience,
+		Specialties:       req.Specialties,
+		Languages:         req.Languages,
+		Availability:      req.Availability,
+	}
+	if user.TrainerProfile != nil {
+		profile.CreatedAt = user.TrainerProfile.CreatedAt
+	}
+	for kind, link := range req.Links {
+		profile.Links = append(profile.Links, models.TrainerLink{UserID: req.UserID, Kind: kind, Url: link})
+	}
+
+	return uc.profiles.Upsert(ctx, profile)
+}
@@ -0,0 +1,63 @@
+package trainers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/trainers"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTrainerProfileUpdaterImpl_Update_NotVerifiedTrainer(t *testing.T) {
+	profiles := new(mocks.TrainerProfiles)
+	users := new(mocks.Users)
+	uc := NewTrainerProfileUpdaterImpl(profiles, users)
+	ctx := context.Background()
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a"}, nil)
+
+	_, err := uc.Update(ctx, trainers.UpdateTrainerProfileRequest{UserID: "a"})
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotVerifiedTrainer)
+	profiles.AssertNotCalled(t, "Upsert")
+}
+
+func TestTrainerProfileUpdaterImpl_Update_RepoError(t *testing.T) {
+	profiles := new(mocks.TrainerProfiles)
+	users := new(mocks.Users)
+	uc := NewTrainerProfileUpdaterImpl(profiles, users)
+	ctx := context.Background()
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a", IsVerifiedTrainer: true}, nil)
+	profiles.On("Upsert", ctx, mock.Anything).Return(models.TrainerProfile{}, errors.New("repo error"))
+
+	_, err := uc.Update(ctx, trainers.UpdateTrainerProfileRequest{UserID: "a"})
+
+	assert.Error(t, err)
+}
+
+func TestTrainerProfileUpdaterImpl_Update_Ok(t *testing.T) {
+	profiles := new(mocks.TrainerProfiles)
+	users := new(mocks.Users)
+	uc := NewTrainerProfileUpdaterImpl(profiles, users)
+	ctx := context.Background()
+	req := trainers.UpdateTrainerProfileRequest{
+		UserID:            "a",
+		Bio:               "bio",
+		YearsOfExperience: 5,
+		Languages:         []string{"en"},
+		Links:             map[string]string{"website": "https://fiufit.com"},
+	}
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a", IsVerifiedTrainer: true}, nil)
+	profiles.On("Upsert", ctx, mock.MatchedBy(func(profile models.TrainerProfile) bool {
+		return profile.UserID == "a" && profile.Bio == "bio" && profile.YearsOfExperience == 5 &&
+			len(profile.Links) == 1 && profile.Links[0].Kind == "website"
+	})).Return(models.TrainerProfile{UserID: "a"}, nil)
+
+	_, err := uc.Update(ctx, req)
+
+	assert.NoError(t, err)
+}
@@ -17,25 +17,24 @@ type UserEnabler interface {
 type UserEnablerImpl struct {
 	users    repositories.Users
 	metrics  external.Metrics
-	firebase external.Firebase
+	identity external.IdentityProvider
 	logger   *zap.Logger
 }
 
-func NewUserEnablerImpl(users repositories.Users, firebase external.Firebase, metrics external.Metrics, logger *zap.Logger) UserEnablerImpl {
-	return UserEnablerImpl{users: users, firebase: firebase, metrics: metrics, logger: logger}
+func NewUserEnablerImpl(users repositories.Users, identity external.IdentityProvider, metrics external.Metrics, logger *zap.Logger) UserEnablerImpl {
+	return UserEnablerImpl{users: users, identity: identity, metrics: metrics, logger: logger}
 }
 
 func (uc UserEnablerImpl) EnableUser(ctx context.Context, userID string) error {
-	usr, err := uc.users.GetByID(ctx, userID)
+	_, err := uc.users.GetByID(ctx, userID)
 	if err != nil {
 		return err
 	}
-	err = uc.firebase.EnableUser(ctx, userID)
+	err = uc.identity.EnableUser(ctx, userID)
 	if err != nil {
 		return err
 	}
-	usr.Disabled = false
-	_, err = uc.users.Update(ctx, usr)
+	err = uc.users.SetDisabled(ctx, userID, false)
 	if err != nil {
 		uc.logger.Error("Unable to fully enable user", zap.Error(err), zap.Any("user", userID))
 	}
@@ -43,16 +42,15 @@ func (uc UserEnablerImpl) EnableUser(ctx context.Context, userID string) error {
 }
 
 func (uc UserEnablerImpl) DisableUser(ctx context.Context, userID string) error {
-	usr, err := uc.users.GetByID(ctx, userID)
+	_, err := uc.users.GetByID(ctx, userID)
 	if err != nil {
 		return err
 	}
-	err = uc.firebase.DisableUser(ctx, userID)
+	err = uc.identity.DisableUser(ctx, userID)
 	if err != nil {
 		return err
 	}
-	usr.Disabled = true
-	_, err = uc.users.Update(ctx, usr)
+	err = uc.users.SetDisabled(ctx, userID, true)
 	if err != nil {
 		uc.logger.Error("Unable to fully disable user", zap.Error(err), zap.Any("user", userID))
 		return err
@@ -18,13 +18,12 @@ func TestEnableUserOk(t *testing.T) {
 	uid := "123456789"
 	user := models.User{ID: uid}
 	userRepo := new(mocks.Users)
-	firebaseRepo := new(mocks.Firebase)
+	firebaseRepo := new(mocks.IdentityProvider)
 	metricsRepo := new(mocks.Metrics)
 
 	firebaseRepo.On("EnableUser", ctx, uid).Return(nil)
 	userRepo.On("GetByID", ctx, uid).Return(user, nil)
-	user.Disabled = false
-	userRepo.On("Update", ctx, user).Return(user, nil)
+	userRepo.On("SetDisabled", ctx, uid, false).Return(nil)
 	enableUserUc := NewUserEnablerImpl(userRepo, firebaseRepo, metricsRepo, zaptest.NewLogger(t))
 	err := enableUserUc.EnableUser(ctx, uid)
 
@@ -37,7 +36,7 @@ func TestEnableUserError(t *testing.T) {
 	uid := "123456789"
 	user := models.User{ID: uid}
 	userRepo := new(mocks.Users)
-	firebaseRepo := new(mocks.Firebase)
+	firebaseRepo := new(mocks.IdentityProvider)
 	metricsRepo := new(mocks.Metrics)
 
 	firebaseRepo.On("EnableUser", ctx, uid).Return(contracts.ErrUserNotDisabled)
@@ -53,7 +52,7 @@ func TestEnableUserNotFoundError(t *testing.T) {
 	ctx := context.Background()
 	uid := "notFound"
 	userRepo := new(mocks.Users)
-	firebaseRepo := new(mocks.Firebase)
+	firebaseRepo := new(mocks.IdentityProvider)
 	metricsRepo := new(mocks.Metrics)
 
 	userRepo.On("GetByID", ctx, uid).Return(models.User{}, contracts.ErrUserNotFound)
@@ -69,7 +68,7 @@ func TestDisableUserOk(t *testing.T) {
 	uid := "123456789"
 	user := models.User{ID: uid}
 	userRepo := new(mocks.Users)
-	firebaseRepo := new(mocks.Firebase)
+	firebaseRepo := new(mocks.IdentityProvider)
 	metricsRepo := new(mocks.Metrics)
 
 	metricsReq := metrics.CreateMetricRequest{
@@ -79,8 +78,7 @@ func TestDisableUserOk(t *testing.T) {
 
 	firebaseRepo.On("DisableUser", ctx, uid).Return(nil)
 	userRepo.On("GetByID", ctx, uid).Return(user, nil)
-	user.Disabled = true
-	userRepo.On("Update", ctx, user).Return(user, nil)
+	userRepo.On("SetDisabled", ctx, uid, true).Return(nil)
 	enableUserUc := NewUserEnablerImpl(userRepo, firebaseRepo, metricsRepo, zaptest.NewLogger(t))
 	err := enableUserUc.DisableUser(ctx, uid)
 
@@ -93,7 +91,7 @@ func TestDisableUserError(t *testing.T) {
 	uid := "123456789"
 	user := models.User{ID: uid}
 	userRepo := new(mocks.Users)
-	firebaseRepo := new(mocks.Firebase)
+	firebaseRepo := new(mocks.IdentityProvider)
 	metricsRepo := new(mocks.Metrics)
 
 	firebaseRepo.On("DisableUser", ctx, uid).Return(contracts.ErrUserAlreadyDisabled)
@@ -109,7 +107,7 @@ func TestDisableUserNotFoundError(t *testing.T) {
 	ctx := context.Background()
 	uid := "notFound"
 	userRepo := new(mocks.Users)
-	firebaseRepo := new(mocks.Firebase)
+	firebaseRepo := new(mocks.IdentityProvider)
 	metricsRepo := new(mocks.Metrics)
 
 	userRepo.On("GetByID", ctx, uid).Return(models.User{}, contracts.ErrUserNotFound)
@@ -1,6 +1,7 @@
 package users
 
 import (
+	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/metrics"
 	"github.com/fiufit/users/contracts/users"
 	"github.com/fiufit/users/repositories"
@@ -12,6 +13,7 @@ import (
 type UserFollower interface {
 	FollowUser(ctx context.Context, req users.FollowUserRequest) error
 	UnfollowUser(ctx context.Context, req users.UnfollowUserRequest) error
+	BatchFollow(ctx context.Context, req users.BatchFollowRequest) (users.BatchFollowResponse, error)
 }
 
 type UserFollowerImpl struct {
@@ -26,6 +28,10 @@ func NewUserFollowerImpl(users repositories.Users, notifications external.Notifi
 }
 
 func (uc UserFollowerImpl) FollowUser(ctx context.Context, req users.FollowUserRequest) error {
+	if req.FollowedUserID == req.FollowerUserID {
+		return contracts.ErrCannotFollowSelf
+	}
+
 	followedUser, err := uc.users.GetByID(ctx, req.FollowedUserID)
 	if err != nil {
 		return err
@@ -35,7 +41,7 @@ func (uc UserFollowerImpl) FollowUser(ctx context.Context, req users.FollowUserR
 	if err != nil {
 		return err
 	}
-	err = uc.users.FollowUser(ctx, followedUser, followerUser)
+	err = uc.users.FollowUser(ctx, followedUser, followerUser, req.Source)
 	if err == nil {
 		if uc.notifications.SendFollowersNotification(ctx, followerUser, followedUser) != nil {
 			uc.logger.Error("Error sending notification", zap.Error(err))
@@ -51,5 +57,33 @@ func (uc UserFollowerImpl) FollowUser(ctx context.Context, req users.FollowUserR
 }
 
 func (uc UserFollowerImpl) UnfollowUser(ctx context.Context, req users.UnfollowUserRequest) error {
+	if req.FollowedUserID == req.FollowerUserID {
+		return contracts.ErrNotFollowing
+	}
 	return uc.users.UnfollowUser(ctx, req.FollowedUserID, req.FollowerUserID)
 }
+
+func (uc UserFollowerImpl) BatchFollow(ctx context.Context, req users.BatchFollowRequest) (users.BatchFollowResponse, error) {
+	followerUser, err := uc.users.GetByID(ctx, req.FollowerUserID)
+	if err != nil {
+		return users.BatchFollowResponse{}, err
+	}
+
+	res, followedUsers, err := uc.users.BatchFollow(ctx, followerUser, req)
+	if err != nil {
+		return users.BatchFollowResponse{}, err
+	}
+
+	for _, followedUser := range followedUsers {
+		if err := uc.notifications.SendFollowersNotification(ctx, followerUser, followedUser); err != nil {
+			uc.logger.Error("Error sending notification", zap.Error(err))
+		}
+
+		followMetric := metrics.CreateMetricRequest{
+			MetricType: "user_followed",
+			SubType:    followedUser.ID,
+		}
+		uc.metrics.Create(ctx, followMetric)
+	}
+	return res, nil
+}
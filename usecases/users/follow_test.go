@@ -55,7 +55,7 @@ func TestUserFollowerImpl_FollowUser_RepoError(t *testing.T) {
 	req := uContracts.FollowUserRequest{FollowerUserID: "a", FollowedUserID: "b"}
 	users.On("GetByID", ctx, req.FollowedUserID).Return(models.User{}, nil)
 	users.On("GetByID", ctx, req.FollowerUserID).Return(models.User{}, nil)
-	users.On("FollowUser", ctx, models.User{}, models.User{}).Return(errors.New("repo error"))
+	users.On("FollowUser", ctx, models.User{}, models.User{}, "").Return(errors.New("repo error"))
 	err := userFollower.FollowUser(ctx, req)
 
 	assert.Error(t, err)
@@ -71,10 +71,119 @@ func TestUserFollowerImpl_FollowUser_Ok(t *testing.T) {
 	req := uContracts.FollowUserRequest{FollowerUserID: "a", FollowedUserID: "b"}
 	users.On("GetByID", ctx, req.FollowedUserID).Return(models.User{}, nil)
 	users.On("GetByID", ctx, req.FollowerUserID).Return(models.User{}, nil)
-	users.On("FollowUser", ctx, models.User{}, models.User{}).Return(nil)
+	users.On("FollowUser", ctx, models.User{}, models.User{}, "").Return(nil)
 	notifications.On("SendFollowersNotification", ctx, models.User{}, models.User{}).Return(nil)
 	metrics.On("Create", ctx, metrics2.CreateMetricRequest{MetricType: "user_followed", SubType: ""}).Return(nil)
 	err := userFollower.FollowUser(ctx, req)
 
 	assert.NoError(t, err)
 }
+
+func TestUserFollowerImpl_BatchFollow_FollowerDoesNotExist(t *testing.T) {
+	users := new(mocks.Users)
+	metrics := new(mocks.Metrics)
+	notifications := new(mocks.Notifications)
+	logger := zaptest.NewLogger(t)
+	userFollower := NewUserFollowerImpl(users, notifications, metrics, logger)
+	ctx := context.Background()
+	req := uContracts.BatchFollowRequest{FollowerUserID: "a", Follow: []string{"b"}}
+	users.On("GetByID", ctx, req.FollowerUserID).Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := userFollower.BatchFollow(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserFollowerImpl_BatchFollow_RepoError(t *testing.T) {
+	users := new(mocks.Users)
+	metrics := new(mocks.Metrics)
+	notifications := new(mocks.Notifications)
+	logger := zaptest.NewLogger(t)
+	userFollower := NewUserFollowerImpl(users, notifications, metrics, logger)
+	ctx := context.Background()
+	req := uContracts.BatchFollowRequest{FollowerUserID: "a", Follow: []string{"b"}}
+	follower := models.User{ID: "a"}
+	users.On("GetByID", ctx, req.FollowerUserID).Return(follower, nil)
+	users.On("BatchFollow", ctx, follower, req).Return(uContracts.BatchFollowResponse{}, nil, errors.New("repo error"))
+
+	_, err := userFollower.BatchFollow(ctx, req)
+
+	assert.Error(t, err)
+}
+
+func TestUserFollowerImpl_BatchFollow_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	metrics := new(mocks.Metrics)
+	notifications := new(mocks.Notifications)
+	logger := zaptest.NewLogger(t)
+	userFollower := NewUserFollowerImpl(users, notifications, metrics, logger)
+	ctx := context.Background()
+	req := uContracts.BatchFollowRequest{FollowerUserID: "a", Follow: []string{"b", "c"}, Unfollow: []string{"d"}}
+	follower := models.User{ID: "a"}
+	followed := models.User{ID: "b"}
+	res := uContracts.BatchFollowResponse{Results: []uContracts.BatchFollowResult{
+		{UserID: "b", Status: uContracts.BatchFollowStatusFollowed},
+		{UserID: "c", Status: uContracts.BatchFollowStatusAlreadyFollowed},
+		{UserID: "d", Status: uContracts.BatchFollowStatusUnfollowed},
+	}}
+	users.On("GetByID", ctx, req.FollowerUserID).Return(follower, nil)
+	users.On("BatchFollow", ctx, follower, req).Return(res, []models.User{followed}, nil)
+	notifications.On("SendFollowersNotification", ctx, follower, followed).Return(nil).Once()
+	metrics.On("Create", ctx, metrics2.CreateMetricRequest{MetricType: "user_followed", SubType: "b"}).Return(nil).Once()
+
+	batchRes, err := userFollower.BatchFollow(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, res, batchRes)
+	notifications.AssertExpectations(t)
+	metrics.AssertExpectations(t)
+}
+
+func TestUserFollowerImpl_FollowUser_Self(t *testing.T) {
+	users := new(mocks.Users)
+	metrics := new(mocks.Metrics)
+	notifications := new(mocks.Notifications)
+	logger := zaptest.NewLogger(t)
+	userFollower := NewUserFollowerImpl(users, notifications, metrics, logger)
+	ctx := context.Background()
+	req := uContracts.FollowUserRequest{FollowerUserID: "a", FollowedUserID: "a"}
+
+	err := userFollower.FollowUser(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrCannotFollowSelf)
+	users.AssertNotCalled(t, "FollowUser")
+}
+
+func TestUserFollowerImpl_FollowUser_AlreadyFollowing(t *testing.T) {
+	users := new(mocks.Users)
+	metrics := new(mocks.Metrics)
+	notifications := new(mocks.Notifications)
+	logger := zaptest.NewLogger(t)
+	userFollower := NewUserFollowerImpl(users, notifications, metrics, logger)
+	ctx := context.Background()
+	req := uContracts.FollowUserRequest{FollowerUserID: "a", FollowedUserID: "b"}
+	users.On("GetByID", ctx, req.FollowedUserID).Return(models.User{}, nil)
+	users.On("GetByID", ctx, req.FollowerUserID).Return(models.User{}, nil)
+	users.On("FollowUser", ctx, models.User{}, models.User{}, "").Return(contracts.ErrAlreadyFollowing)
+
+	err := userFollower.FollowUser(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrAlreadyFollowing)
+	notifications.AssertNotCalled(t, "SendFollowersNotification")
+	metrics.AssertNotCalled(t, "Create")
+}
+
+func TestUserFollowerImpl_UnfollowUser_NotFollowing(t *testing.T) {
+	users := new(mocks.Users)
+	metrics := new(mocks.Metrics)
+	notifications := new(mocks.Notifications)
+	logger := zaptest.NewLogger(t)
+	userFollower := NewUserFollowerImpl(users, notifications, metrics, logger)
+	ctx := context.Background()
+	req := uContracts.UnfollowUserRequest{FollowerUserID: "a", FollowedUserID: "b"}
+	users.On("UnfollowUser", ctx, req.FollowedUserID, req.FollowerUserID).Return(contracts.ErrNotFollowing)
+
+	err := userFollower.UnfollowUser(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrNotFollowing)
+}
@@ -2,7 +2,10 @@ package users
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/users"
 	"github.com/fiufit/users/models"
 	"github.com/fiufit/users/repositories"
@@ -19,12 +22,14 @@ type UserGetter interface {
 }
 
 type UserGetterImpl struct {
-	users  repositories.Users
-	logger *zap.Logger
+	users           repositories.Users
+	nicknameChanges repositories.NicknameChanges
+	nicknames       models.NicknamePolicy
+	logger          *zap.Logger
 }
 
-func NewUserGetterImpl(users repositories.Users, logger *zap.Logger) UserGetterImpl {
-	return UserGetterImpl{users: users, logger: logger}
+func NewUserGetterImpl(users repositories.Users, nicknameChanges repositories.NicknameChanges, nicknames models.NicknamePolicy, logger *zap.Logger) UserGetterImpl {
+	return UserGetterImpl{users: users, nicknameChanges: nicknameChanges, nicknames: nicknames, logger: logger}
 }
 
 func (uc *UserGetterImpl) GetUserByID(ctx context.Context, uid string) (models.User, error) {
@@ -35,11 +40,27 @@ func (uc *UserGetterImpl) GetUserByID(ctx context.Context, uid string) (models.U
 	return user, nil
 }
 
+// GetUserByNickname also finds users by the nicknames they released within the nickname hold period, in which case
+// the user is flagged with NicknameRedirect.
 func (uc *UserGetterImpl) GetUserByNickname(ctx context.Context, nickname string) (models.User, error) {
 	user, err := uc.users.GetByNickname(ctx, nickname)
-	if err != nil {
+	if !errors.Is(err, contracts.ErrUserNotFound) || uc.nicknames.HoldPeriod <= 0 {
 		return user, err
 	}
+
+	changes, err := uc.nicknameChanges.GetByNickname(ctx, nickname, time.Now().Add(-uc.nicknames.HoldPeriod))
+	if err != nil {
+		return models.User{}, err
+	}
+	if len(changes) == 0 {
+		return models.User{}, contracts.ErrUserNotFound
+	}
+
+	user, err = uc.users.GetByID(ctx, changes[0].UserID)
+	if err != nil {
+		return models.User{}, err
+	}
+	user.NicknameRedirect = true
 	return user, nil
 }
 
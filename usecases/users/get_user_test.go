@@ -21,7 +21,7 @@ func TestGetUserByIdError(t *testing.T) {
 
 	//when
 	userRepo.On("GetByID", ctx, userID).Return(models.User{}, errors.New("repo error"))
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 	_, err := userUc.GetUserByID(ctx, userID)
 
 	//then
@@ -37,7 +37,7 @@ func TestGetUserByIdOk(t *testing.T) {
 
 	//when
 	userRepo.On("GetByID", ctx, userID).Return(user, nil)
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 	res, err := userUc.GetUserByID(ctx, userID)
 
 	//then
@@ -53,7 +53,7 @@ func TestGetUserByNicknameError(t *testing.T) {
 
 	//when
 	userRepo.On("GetByNickname", ctx, username).Return(models.User{}, errors.New("repo error"))
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 	_, err := userUc.GetUserByNickname(ctx, username)
 
 	//then
@@ -69,7 +69,7 @@ func TestGetUserByNicknameOk(t *testing.T) {
 
 	//when
 	userRepo.On("GetByNickname", ctx, username).Return(user, nil)
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 	res, err := userUc.GetUserByNickname(ctx, username)
 
 	//then
@@ -87,7 +87,7 @@ func TestGetUsersError(t *testing.T) {
 
 	//when
 	userRepo.On("Get", ctx, req).Return(users.GetUsersResponse{}, errors.New("repo error"))
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 	_, err := userUc.GetUsers(ctx, req)
 
 	//then
@@ -108,7 +108,7 @@ func TestGetUsersOk(t *testing.T) {
 
 	//when
 	userRepo.On("Get", ctx, req).Return(res, nil)
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 	_, err := userUc.GetUsers(ctx, req)
 
 	//then
@@ -123,7 +123,7 @@ func TestGetClosestUsers_ErrUserNotFound(t *testing.T) {
 		UserID: "H014",
 	}
 	userRepo.On("GetByID", ctx, req.UserID).Return(models.User{}, contracts.ErrUserNotFound)
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 
 	//when
 	_, err := userUc.GetClosestUsers(ctx, req)
@@ -142,7 +142,7 @@ func TestGetClosestUsers_GetByDistanceError(t *testing.T) {
 	}
 	userRepo.On("GetByID", ctx, req.UserID).Return(models.User{ID: req.UserID}, nil)
 	userRepo.On("GetByDistance", ctx, req).Return(users.GetUsersResponse{}, errors.New("repo error"))
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 
 	//when
 	_, err := userUc.GetClosestUsers(ctx, req)
@@ -160,7 +160,7 @@ func TestGetClosestUsers_Ok(t *testing.T) {
 	}
 	userRepo.On("GetByID", ctx, req.UserID).Return(models.User{ID: req.UserID}, nil)
 	userRepo.On("GetByDistance", ctx, req).Return(users.GetUsersResponse{}, nil)
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 
 	//when
 	_, err := userUc.GetClosestUsers(ctx, req)
@@ -177,7 +177,7 @@ func TestGetUserFollowers_Error(t *testing.T) {
 		UserID: "H014",
 	}
 	userRepo.On("GetFollowers", ctx, req).Return(users.GetUserFollowersResponse{}, errors.New("repo error"))
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 
 	//when
 	_, err := userUc.GetUserFollowers(ctx, req)
@@ -194,7 +194,7 @@ func TestGetUserFollowers_Ok(t *testing.T) {
 		UserID: "H014",
 	}
 	userRepo.On("GetFollowers", ctx, req).Return(users.GetUserFollowersResponse{}, nil)
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 
 	//when
 	_, err := userUc.GetUserFollowers(ctx, req)
@@ -211,7 +211,7 @@ func TestGetUserFollowed_Error(t *testing.T) {
 		UserID: "H014",
 	}
 	userRepo.On("GetFollowed", ctx, req).Return(users.GetFollowedUsersResponse{}, errors.New("repo error"))
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 
 	//when
 	_, err := userUc.GetUserFollowed(ctx, req)
@@ -228,7 +228,7 @@ func TestGetUserFollowed_Ok(t *testing.T) {
 		UserID: "H014",
 	}
 	userRepo.On("GetFollowed", ctx, req).Return(users.GetFollowedUsersResponse{}, nil)
-	userUc := NewUserGetterImpl(userRepo, zaptest.NewLogger(t))
+	userUc := NewUserGetterImpl(userRepo, new(mocks.NicknameChanges), models.NicknamePolicy{}, zaptest.NewLogger(t))
 
 	//when
 	_, err := userUc.GetUserFollowed(ctx, req)
@@ -0,0 +1,46 @@
+package users
+
+import (
+	"context"
+
+	"github.com/fiufit/users/repositories"
+	"go.uber.org/zap"
+)
+
+const locationBackfillBatchSize = 500
+
+type LocationBackfiller interface {
+	BackfillLocations(ctx context.Context) (int, error)
+}
+
+type LocationBackfillerImpl struct {
+	users  repositories.Users
+	logger *zap.Logger
+}
+
+func NewLocationBackfillerImpl(users repositories.Users, logger *zap.Logger) LocationBackfillerImpl {
+	return LocationBackfillerImpl{users: users, logger: logger}
+}
+
+// BackfillLocations stores the locations of users registered before locations were stored, or resolved with an older
+// dataset, in batches until every user was tried once. It returns how many users were updated.
+func (uc LocationBackfillerImpl) BackfillLocations(ctx context.Context) (int, error) {
+	total := 0
+	afterID := ""
+	for {
+		updated, lastID, err := uc.users.BackfillLocations(ctx, afterID, locationBackfillBatchSize)
+		total += updated
+		if err != nil {
+			return total, err
+		}
+		if lastID == "" {
+			break
+		}
+		afterID = lastID
+	}
+
+	if total > 0 {
+		uc.logger.Info("Backfilled user locations", zap.Int("users", total))
+	}
+	return total, nil
+}
@@ -0,0 +1,39 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestLocationBackfillerImpl_BackfillLocations_RepoError(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewLocationBackfillerImpl(users, zaptest.NewLogger(t))
+	ctx := context.Background()
+	users.On("BackfillLocations", ctx, "", locationBackfillBatchSize).Return(0, "", errors.New("repo error"))
+
+	_, err := uc.BackfillLocations(ctx)
+
+	assert.Error(t, err)
+}
+
+func TestLocationBackfillerImpl_BackfillLocations_RunsUntilDone(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewLocationBackfillerImpl(users, zaptest.NewLogger(t))
+	ctx := context.Background()
+	users.On("BackfillLocations", ctx, "", locationBackfillBatchSize).Return(locationBackfillBatchSize, "b", nil).Once()
+	// unresolved users don't count as updated, but the backfill moves past them
+	users.On("BackfillLocations", ctx, "b", locationBackfillBatchSize).Return(0, "d", nil).Once()
+	users.On("BackfillLocations", ctx, "d", locationBackfillBatchSize).Return(3, "f", nil).Once()
+	users.On("BackfillLocations", ctx, "f", locationBackfillBatchSize).Return(0, "", nil).Once()
+
+	updated, err := uc.BackfillLocations(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, locationBackfillBatchSize+3, updated)
+	users.AssertNumberOfCalls(t, "BackfillLocations", 4)
+}
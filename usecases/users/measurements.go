@@ -0,0 +1,30 @@
+package users
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/repositories"
+)
+
+type UserMeasurements interface {
+	GetMeasurements(ctx context.Context, req users.GetMeasurementsRequest) (users.GetMeasurementsResponse, error)
+}
+
+type UserMeasurementsImpl struct {
+	users        repositories.Users
+	measurements repositories.Measurements
+}
+
+func NewUserMeasurementsImpl(users repositories.Users, measurements repositories.Measurements) UserMeasurementsImpl {
+	return UserMeasurementsImpl{users: users, measurements: measurements}
+}
+
+func (uc UserMeasurementsImpl) GetMeasurements(ctx context.Context, req users.GetMeasurementsRequest) (users.GetMeasurementsResponse, error) {
+	_, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return users.GetMeasurementsResponse{}, err
+	}
+
+	return uc.measurements.Get(ctx, req)
+}
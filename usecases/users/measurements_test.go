@@ -0,0 +1,56 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	uContracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserMeasurementsImpl_GetMeasurements_UserNotFound(t *testing.T) {
+	users := new(mocks.Users)
+	measurements := new(mocks.Measurements)
+	uc := NewUserMeasurementsImpl(users, measurements)
+	ctx := context.Background()
+	req := uContracts.GetMeasurementsRequest{UserID: "a"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := uc.GetMeasurements(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserMeasurementsImpl_GetMeasurements_RepoError(t *testing.T) {
+	users := new(mocks.Users)
+	measurements := new(mocks.Measurements)
+	uc := NewUserMeasurementsImpl(users, measurements)
+	ctx := context.Background()
+	req := uContracts.GetMeasurementsRequest{UserID: "a"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, nil)
+	measurements.On("Get", ctx, req).Return(uContracts.GetMeasurementsResponse{}, errors.New("repo error"))
+
+	_, err := uc.GetMeasurements(ctx, req)
+
+	assert.Error(t, err)
+}
+
+func TestUserMeasurementsImpl_GetMeasurements_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	measurements := new(mocks.Measurements)
+	uc := NewUserMeasurementsImpl(users, measurements)
+	ctx := context.Background()
+	req := uContracts.GetMeasurementsRequest{UserID: "a", GroupBy: uContracts.MeasurementsGroupByWeek}
+	res := uContracts.GetMeasurementsResponse{Measurements: []models.Measurement{{UserID: "a", Height: 180, Weight: 81}}}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, nil)
+	measurements.On("Get", ctx, req).Return(res, nil)
+
+	measurementsRes, err := uc.GetMeasurements(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, res, measurementsRes)
+}
@@ -0,0 +1,59 @@
+package users
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+)
+
+type UserRenamer interface {
+	ForceRename(ctx context.Context, req ucontracts.ForceRenameRequest) (models.User, error)
+}
+
+type UserRenamerImpl struct {
+	users repositories.Users
+}
+
+func NewUserRenamerImpl(users repositories.Users) UserRenamerImpl {
+	return UserRenamerImpl{users: users}
+}
+
+// ForceRename lets admins replace offending nicknames. The nickname policy isn't applied, so admins can also hand
+// out reserved nicknames. The replaced nickname isn't kept in the nickname history, so it doesn't redirect to the user.
+func (uc UserRenamerImpl) ForceRename(ctx context.Context, req ucontracts.ForceRenameRequest) (models.User, error) {
+	user, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if err := checkNicknameAvailable(ctx, uc.users, req.Nickname, user.ID); err != nil {
+		return models.User{}, err
+	}
+
+	if err := uc.users.SetNickname(ctx, user.ID, req.Nickname); err != nil {
+		return models.User{}, err
+	}
+
+	user.Nickname = req.Nickname
+	return user, nil
+}
+
+// checkNicknameAvailable fails with contracts.ErrUserAlreadyExists if nickname, ignoring case, belongs to a user
+// other than userID.
+func checkNicknameAvailable(ctx context.Context, users repositories.Users, nickname string, userID string) error {
+	owner, err := users.GetByNickname(ctx, nickname)
+	if errors.Is(err, contracts.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if owner.ID != userID {
+		return contracts.ErrUserAlreadyExists
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package users
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	uContracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestUserRenamerImpl_ForceRename_UserNotFound(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRenamerImpl(users)
+	ctx := context.Background()
+	req := uContracts.ForceRenameRequest{UserID: "a", Nickname: "renamed"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := uc.ForceRename(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserRenamerImpl_ForceRename_NicknameTaken(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRenamerImpl(users)
+	ctx := context.Background()
+	req := uContracts.ForceRenameRequest{UserID: "a", Nickname: "Coach"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a", Nickname: "badword"}, nil)
+	users.On("GetByNickname", ctx, req.Nickname).Return(models.User{ID: "b", Nickname: "coach"}, nil)
+
+	_, err := uc.ForceRename(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserAlreadyExists)
+}
+
+func TestUserRenamerImpl_ForceRename_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRenamerImpl(users)
+	ctx := context.Background()
+	req := uContracts.ForceRenameRequest{UserID: "a", Nickname: "fiufit"}
+	renamed := models.User{ID: "a", Nickname: "fiufit"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a", Nickname: "badword"}, nil)
+	users.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, contracts.ErrUserNotFound)
+	users.On("SetNickname", ctx, "a", req.Nickname).Return(nil)
+
+	user, err := uc.ForceRename(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, renamed, user)
+	users.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestPatchUserModelNicknameCaseChange(t *testing.T) {
+	users := new(mocks.Users)
+	ctx := context.Background()
+	outdatedUser := models.User{ID: "a", Nickname: "coach"}
+	req := uContracts.UpdateUserRequest{ID: "a", Nickname: "Coach"}
+	users.On("GetByNickname", ctx, req.Nickname).Return(outdatedUser, nil)
+
+	uc := NewUserUpdaterImpl(users, new(mocks.NicknameChanges), new(mocks.Metrics), models.NicknamePolicy{})
+	updatedUser, err := uc.patchUserModel(ctx, outdatedUser, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Coach", updatedUser.Nickname)
+}
+
+func TestPatchUserModelNicknameNotAllowed(t *testing.T) {
+	policy := models.NewNicknamePolicy(models.DefaultReservedNicknames, models.DefaultProfaneWords)
+	uc := NewUserUpdaterImpl(new(mocks.Users), new(mocks.NicknameChanges), new(mocks.Metrics), policy)
+	ctx := context.Background()
+
+	for _, nickname := range []string{"Admin", "f.i.u.f.i.t", "admin_01", "4dmin", "sh1t_lord", "CoachPuta", "p.u.t.a.s"} {
+		_, err := uc.patchUserModel(ctx, models.User{ID: "a", Nickname: "coach"}, uContracts.UpdateUserRequest{ID: "a", Nickname: nickname})
+
+		var validationErr *contracts.ValidationError
+		assert.ErrorAs(t, err, &validationErr, nickname)
+	}
+}
+
+func TestPatchUserModelNicknameChangeTooSoon(t *testing.T) {
+	users := new(mocks.Users)
+	nicknameChanges := new(mocks.NicknameChanges)
+	ctx := context.Background()
+	req := uContracts.UpdateUserRequest{ID: "a", Nickname: "renamed"}
+	users.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, contracts.ErrUserNotFound)
+	nicknameChanges.On("GetByUser", ctx, "a", mock.Anything).Return([]models.NicknameChange{{UserID: "a", Nickname: "old"}}, nil)
+
+	policy := models.NicknamePolicy{ChangeInterval: time.Hour}
+	uc := NewUserUpdaterImpl(users, nicknameChanges, new(mocks.Metrics), policy)
+	_, err := uc.patchUserModel(ctx, models.User{ID: "a", Nickname: "coach"}, req)
+
+	assert.ErrorIs(t, err, contracts.ErrNicknameChangeTooSoon)
+}
+
+func TestPatchUserModelNicknameOnHold(t *testing.T) {
+	users := new(mocks.Users)
+	nicknameChanges := new(mocks.NicknameChanges)
+	ctx := context.Background()
+	req := uContracts.UpdateUserRequest{ID: "a", Nickname: "released"}
+	users.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, contracts.ErrUserNotFound)
+	nicknameChanges.On("GetByNickname", ctx, req.Nickname, mock.Anything).Return([]models.NicknameChange{{UserID: "b", Nickname: "released"}}, nil)
+
+	policy := models.NicknamePolicy{HoldPeriod: time.Hour}
+	uc := NewUserUpdaterImpl(users, nicknameChanges, new(mocks.Metrics), policy)
+	_, err := uc.patchUserModel(ctx, models.User{ID: "a", Nickname: "coach"}, req)
+
+	assert.ErrorIs(t, err, contracts.ErrNicknameOnHold)
+}
+
+func TestPatchUserModelNicknameReclaim(t *testing.T) {
+	users := new(mocks.Users)
+	nicknameChanges := new(mocks.NicknameChanges)
+	ctx := context.Background()
+	req := uContracts.UpdateUserRequest{ID: "a", Nickname: "released"}
+	users.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, contracts.ErrUserNotFound)
+	nicknameChanges.On("GetByUser", ctx, "a", mock.Anything).Return([]models.NicknameChange{}, nil)
+	nicknameChanges.On("GetByNickname", ctx, req.Nickname, mock.Anything).Return([]models.NicknameChange{{UserID: "a", Nickname: "released"}}, nil)
+
+	policy := models.NicknamePolicy{HoldPeriod: time.Hour, ChangeInterval: time.Hour}
+	uc := NewUserUpdaterImpl(users, nicknameChanges, new(mocks.Metrics), policy)
+	updatedUser, err := uc.patchUserModel(ctx, models.User{ID: "a", Nickname: "coach"}, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "released", updatedUser.Nickname)
+}
+
+func TestGetUserByNicknameRedirect(t *testing.T) {
+	users := new(mocks.Users)
+	nicknameChanges := new(mocks.NicknameChanges)
+	ctx := context.Background()
+	users.On("GetByNickname", ctx, "released").Return(models.User{}, contracts.ErrUserNotFound)
+	users.On("GetByID", ctx, "a").Return(models.User{ID: "a", Nickname: "current"}, nil)
+	nicknameChanges.On("GetByNickname", ctx, "released", mock.Anything).Return([]models.NicknameChange{{UserID: "a", Nickname: "released"}}, nil)
+
+	uc := NewUserGetterImpl(users, nicknameChanges, models.NicknamePolicy{HoldPeriod: time.Hour}, zaptest.NewLogger(t))
+	user, err := uc.GetUserByNickname(ctx, "released")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a", user.ID)
+	assert.True(t, user.NicknameRedirect)
+}
+
+func TestGetUserByNicknameNoRedirect(t *testing.T) {
+	users := new(mocks.Users)
+	nicknameChanges := new(mocks.NicknameChanges)
+	ctx := context.Background()
+	users.On("GetByNickname", ctx, "unknown").Return(models.User{}, contracts.ErrUserNotFound)
+	nicknameChanges.On("GetByNickname", ctx, "unknown", mock.Anything).Return([]models.NicknameChange{}, nil)
+
+	uc := NewUserGetterImpl(users, nicknameChanges, models.NicknamePolicy{HoldPeriod: time.Hour}, zaptest.NewLogger(t))
+	_, err := uc.GetUserByNickname(ctx, "unknown")
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
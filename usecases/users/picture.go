@@ -0,0 +1,52 @@
+package users
+
+import (
+	"context"
+	"time"
+
+	"github.com/fiufit/users/contracts"
+	ucontracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories"
+	"github.com/fiufit/users/repositories/external"
+	"github.com/fiufit/users/utils"
+)
+
+type UserPictureUpdater interface {
+	UpdatePicture(ctx context.Context, req ucontracts.UpdatePictureRequest) (models.User, error)
+}
+
+type UserPictureUpdaterImpl struct {
+	users repositories.Users
+	auth  external.Firebase
+}
+
+func NewUserPictureUpdaterImpl(users repositories.Users, auth external.Firebase) UserPictureUpdaterImpl {
+	return UserPictureUpdaterImpl{users: users, auth: auth}
+}
+
+// UpdatePicture re-encodes the uploaded picture and stores it along with its thumbnails. Pictures that can't be
+// processed are rejected as invalid fields.
+func (uc UserPictureUpdaterImpl) UpdatePicture(ctx context.Context, req ucontracts.UpdatePictureRequest) (models.User, error) {
+	_, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	pictures, err := utils.ProcessPicture(req.Picture, models.PictureSizeOriginal, models.PictureThumbnailSizes)
+	if err != nil {
+		validationErr := &contracts.ValidationError{}
+		validationErr.Add("picture", err.Error())
+		return models.User{}, validationErr
+	}
+
+	if err := uc.auth.UploadUserPicture(ctx, req.UserID, pictures); err != nil {
+		return models.User{}, err
+	}
+
+	if err := uc.users.SetPictureUpdatedAt(ctx, req.UserID, time.Now()); err != nil {
+		return models.User{}, err
+	}
+
+	return uc.users.GetByID(ctx, req.UserID)
+}
@@ -0,0 +1,84 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	uContracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testPicture(t *testing.T) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 300, 200))))
+	return buf.Bytes()
+}
+
+func TestUserPictureUpdaterImpl_UpdatePicture_UserNotFound(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserPictureUpdaterImpl(users, new(mocks.Firebase))
+	ctx := context.Background()
+	req := uContracts.UpdatePictureRequest{UserID: "a", Picture: testPicture(t)}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := uc.UpdatePicture(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserPictureUpdaterImpl_UpdatePicture_InvalidPicture(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserPictureUpdaterImpl(users, new(mocks.Firebase))
+	ctx := context.Background()
+	req := uContracts.UpdatePictureRequest{UserID: "a", Picture: []byte("not a picture")}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+
+	_, err := uc.UpdatePicture(ctx, req)
+
+	var validationErr *contracts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "picture", validationErr.Fields[0].Field)
+}
+
+func TestUserPictureUpdaterImpl_UpdatePicture_UploadError(t *testing.T) {
+	users := new(mocks.Users)
+	auth := new(mocks.Firebase)
+	uc := NewUserPictureUpdaterImpl(users, auth)
+	ctx := context.Background()
+	req := uContracts.UpdatePictureRequest{UserID: "a", Picture: testPicture(t)}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	auth.On("UploadUserPicture", ctx, req.UserID, mock.Anything).Return(errors.New("storage error"))
+
+	_, err := uc.UpdatePicture(ctx, req)
+
+	assert.Error(t, err)
+	users.AssertNotCalled(t, "SetPictureUpdatedAt", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserPictureUpdaterImpl_UpdatePicture_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	auth := new(mocks.Firebase)
+	uc := NewUserPictureUpdaterImpl(users, auth)
+	ctx := context.Background()
+	req := uContracts.UpdatePictureRequest{UserID: "a", Picture: testPicture(t)}
+	updatedUser := models.User{ID: "a", PictureUrls: map[string]string{models.PictureSizeOriginal: "url"}}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil).Once()
+	users.On("GetByID", ctx, req.UserID).Return(updatedUser, nil).Once()
+	users.On("SetPictureUpdatedAt", ctx, req.UserID, mock.Anything).Return(nil)
+	auth.On("UploadUserPicture", ctx, req.UserID, mock.MatchedBy(func(pictures map[string][]byte) bool {
+		return len(pictures) == len(models.PictureThumbnailSizes)+1
+	})).Return(nil)
+
+	user, err := uc.UpdatePicture(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, updatedUser, user)
+}
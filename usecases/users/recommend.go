@@ -0,0 +1,39 @@
+package users
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/repositories"
+)
+
+type UserRecommender interface {
+	GetRecommendations(ctx context.Context, req users.GetUserRecommendationsRequest) (users.GetUserRecommendationsResponse, error)
+	GetFollowSuggestions(ctx context.Context, req users.GetFollowSuggestionsRequest) (users.GetFollowSuggestionsResponse, error)
+}
+
+type UserRecommenderImpl struct {
+	users repositories.Users
+}
+
+func NewUserRecommenderImpl(users repositories.Users) UserRecommenderImpl {
+	return UserRecommenderImpl{users: users}
+}
+
+func (uc UserRecommenderImpl) GetRecommendations(ctx context.Context, req users.GetUserRecommendationsRequest) (users.GetUserRecommendationsResponse, error) {
+	_, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return users.GetUserRecommendationsResponse{}, err
+	}
+
+	return uc.users.GetRecommendations(ctx, req)
+}
+
+func (uc UserRecommenderImpl) GetFollowSuggestions(ctx context.Context, req users.GetFollowSuggestionsRequest) (users.GetFollowSuggestionsResponse, error) {
+	_, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return users.GetFollowSuggestionsResponse{}, err
+	}
+
+	return uc.users.GetFollowSuggestions(ctx, req)
+}
@@ -0,0 +1,84 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	uContracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRecommenderImpl_GetRecommendations_UserNotFound(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRecommenderImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetUserRecommendationsRequest{UserID: "a"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := uc.GetRecommendations(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserRecommenderImpl_GetRecommendations_RepoError(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRecommenderImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetUserRecommendationsRequest{UserID: "a"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	users.On("GetRecommendations", ctx, req).Return(uContracts.GetUserRecommendationsResponse{}, errors.New("repo error"))
+
+	_, err := uc.GetRecommendations(ctx, req)
+
+	assert.Error(t, err)
+}
+
+func TestUserRecommenderImpl_GetRecommendations_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRecommenderImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetUserRecommendationsRequest{UserID: "a"}
+	expected := uContracts.GetUserRecommendationsResponse{
+		Recommendations: []uContracts.UserRecommendation{{User: models.User{ID: "b"}, Score: uContracts.RecommendationScore{Total: 3, SharedInterests: 1, InterestsScore: 3}}},
+	}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	users.On("GetRecommendations", ctx, req).Return(expected, nil)
+
+	res, err := uc.GetRecommendations(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, res)
+}
+
+func TestUserRecommenderImpl_GetFollowSuggestions_UserNotFound(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRecommenderImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetFollowSuggestionsRequest{UserID: "a"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := uc.GetFollowSuggestions(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserRecommenderImpl_GetFollowSuggestions_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRecommenderImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetFollowSuggestionsRequest{UserID: "a"}
+	expected := uContracts.GetFollowSuggestionsResponse{
+		Suggestions: []uContracts.FollowSuggestion{{User: models.User{ID: "c"}, MutualCount: 1, MutualPreviews: []uContracts.UserPreview{{ID: "b"}}}},
+	}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	users.On("GetFollowSuggestions", ctx, req).Return(expected, nil)
+
+	res, err := uc.GetFollowSuggestions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, res)
+}
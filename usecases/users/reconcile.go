@@ -0,0 +1,34 @@
+package users
+
+import (
+	"context"
+
+	"github.com/fiufit/users/repositories"
+	"go.uber.org/zap"
+)
+
+type FollowCountReconciler interface {
+	ReconcileFollowCounts(ctx context.Context) (int64, error)
+}
+
+type FollowCountReconcilerImpl struct {
+	users  repositories.Users
+	logger *zap.Logger
+}
+
+func NewFollowCountReconcilerImpl(users repositories.Users, logger *zap.Logger) FollowCountReconcilerImpl {
+	return FollowCountReconcilerImpl{users: users, logger: logger}
+}
+
+// ReconcileFollowCounts repairs drifted follower/following counts, returning how many users were fixed.
+func (uc FollowCountReconcilerImpl) ReconcileFollowCounts(ctx context.Context) (int64, error) {
+	repaired, err := uc.users.ReconcileFollowCounts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if repaired > 0 {
+		uc.logger.Warn("Repaired drifted follow counts", zap.Int64("users", repaired))
+	}
+	return repaired, nil
+}
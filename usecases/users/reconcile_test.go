@@ -0,0 +1,34 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestFollowCountReconcilerImpl_ReconcileFollowCounts_RepoError(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewFollowCountReconcilerImpl(users, zaptest.NewLogger(t))
+	ctx := context.Background()
+	users.On("ReconcileFollowCounts", ctx).Return(int64(0), errors.New("repo error"))
+
+	_, err := uc.ReconcileFollowCounts(ctx)
+
+	assert.Error(t, err)
+}
+
+func TestFollowCountReconcilerImpl_ReconcileFollowCounts_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewFollowCountReconcilerImpl(users, zaptest.NewLogger(t))
+	ctx := context.Background()
+	users.On("ReconcileFollowCounts", ctx).Return(int64(2), nil)
+
+	repaired, err := uc.ReconcileFollowCounts(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), repaired)
+}
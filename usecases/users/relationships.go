@@ -0,0 +1,59 @@
+package users
+
+import (
+	"context"
+
+	"github.com/fiufit/users/contracts"
+	"github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/repositories"
+)
+
+type UserRelationships interface {
+	GetRelationship(ctx context.Context, req users.GetRelationshipRequest) (users.Relationship, error)
+	GetRelationships(ctx context.Context, req users.GetRelationshipsRequest) (users.GetRelationshipsResponse, error)
+	GetMutuals(ctx context.Context, req users.GetMutualsRequest) (users.GetMutualsResponse, error)
+}
+
+type UserRelationshipsImpl struct {
+	users repositories.Users
+}
+
+func NewUserRelationshipsImpl(users repositories.Users) UserRelationshipsImpl {
+	return UserRelationshipsImpl{users: users}
+}
+
+func (uc UserRelationshipsImpl) GetRelationship(ctx context.Context, req users.GetRelationshipRequest) (users.Relationship, error) {
+	res, err := uc.GetRelationships(ctx, users.GetRelationshipsRequest{UserID: req.UserID, UserIDs: []string{req.OtherUserID}})
+	if err != nil {
+		return users.Relationship{}, err
+	}
+
+	if len(res.Relationships) == 0 {
+		return users.Relationship{}, contracts.ErrUserNotFound
+	}
+	return res.Relationships[0], nil
+}
+
+// GetRelationships returns the relationship with every existing user in req.UserIDs, unknown IDs are left out.
+func (uc UserRelationshipsImpl) GetRelationships(ctx context.Context, req users.GetRelationshipsRequest) (users.GetRelationshipsResponse, error) {
+	_, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return users.GetRelationshipsResponse{}, err
+	}
+
+	relationships, err := uc.users.GetRelationships(ctx, req.UserID, req.UserIDs)
+	if err != nil {
+		return users.GetRelationshipsResponse{}, err
+	}
+
+	return users.GetRelationshipsResponse{Relationships: relationships}, nil
+}
+
+func (uc UserRelationshipsImpl) GetMutuals(ctx context.Context, req users.GetMutualsRequest) (users.GetMutualsResponse, error) {
+	_, err := uc.users.GetByID(ctx, req.UserID)
+	if err != nil {
+		return users.GetMutualsResponse{}, err
+	}
+
+	return uc.users.GetMutuals(ctx, req)
+}
@@ -0,0 +1,81 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fiufit/users/contracts"
+	uContracts "github.com/fiufit/users/contracts/users"
+	"github.com/fiufit/users/models"
+	"github.com/fiufit/users/repositories/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRelationshipsImpl_GetRelationship_UserNotFound(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRelationshipsImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetRelationshipRequest{UserID: "a", OtherUserID: "b"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{}, contracts.ErrUserNotFound)
+
+	_, err := uc.GetRelationship(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserRelationshipsImpl_GetRelationship_OtherUserNotFound(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRelationshipsImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetRelationshipRequest{UserID: "a", OtherUserID: "b"}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	users.On("GetRelationships", ctx, req.UserID, []string{req.OtherUserID}).Return([]uContracts.Relationship{}, nil)
+
+	_, err := uc.GetRelationship(ctx, req)
+
+	assert.ErrorIs(t, err, contracts.ErrUserNotFound)
+}
+
+func TestUserRelationshipsImpl_GetRelationship_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRelationshipsImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetRelationshipRequest{UserID: "a", OtherUserID: "b"}
+	expected := uContracts.Relationship{UserID: "b", Follows: true, FollowedBy: true, Mutual: true}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	users.On("GetRelationships", ctx, req.UserID, []string{req.OtherUserID}).Return([]uContracts.Relationship{expected}, nil)
+
+	relationship, err := uc.GetRelationship(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, relationship)
+}
+
+func TestUserRelationshipsImpl_GetRelationships_RepoError(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRelationshipsImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetRelationshipsRequest{UserID: "a", UserIDs: []string{"b", "c"}}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	users.On("GetRelationships", ctx, req.UserID, req.UserIDs).Return(nil, errors.New("repo error"))
+
+	_, err := uc.GetRelationships(ctx, req)
+
+	assert.Error(t, err)
+}
+
+func TestUserRelationshipsImpl_GetMutuals_Ok(t *testing.T) {
+	users := new(mocks.Users)
+	uc := NewUserRelationshipsImpl(users)
+	ctx := context.Background()
+	req := uContracts.GetMutualsRequest{UserID: "a"}
+	expected := uContracts.GetMutualsResponse{Mutuals: []models.User{{ID: "b"}}}
+	users.On("GetByID", ctx, req.UserID).Return(models.User{ID: "a"}, nil)
+	users.On("GetMutuals", ctx, req).Return(expected, nil)
+
+	res, err := uc.GetMutuals(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, res)
+}
@@ -2,7 +2,8 @@ package users
 
 import (
 	"context"
-	"errors"
+	"strings"
+	"time"
 
 	"github.com/fiufit/users/contracts"
 	"github.com/fiufit/users/contracts/metrics"
@@ -17,12 +18,14 @@ type UserUpdater interface {
 }
 
 type UserUpdaterImpl struct {
-	users   repositories.Users
-	metrics external.Metrics
+	users           repositories.Users
+	nicknameChanges repositories.NicknameChanges
+	metrics         external.Metrics
+	nicknames       models.NicknamePolicy
 }
 
-func NewUserUpdaterImpl(users repositories.Users, metrics external.Metrics) UserUpdaterImpl {
-	return UserUpdaterImpl{users: users, metrics: metrics}
+func NewUserUpdaterImpl(users repositories.Users, nicknameChanges repositories.NicknameChanges, metrics external.Metrics, nicknames models.NicknamePolicy) UserUpdaterImpl {
+	return UserUpdaterImpl{users: users, nicknameChanges: nicknameChanges, metrics: metrics, nicknames: nicknames}
 }
 
 func (uc *UserUpdaterImpl) UpdateUser(ctx context.Context, req ucontracts.UpdateUserRequest) (models.User, error) {
@@ -55,12 +58,20 @@ func (uc *UserUpdaterImpl) UpdateUser(ctx context.Context, req ucontracts.Update
 
 func (uc *UserUpdaterImpl) patchUserModel(ctx context.Context, user models.User, req ucontracts.UpdateUserRequest) (models.User, error) {
 	if req.Nickname != "" && req.Nickname != user.Nickname {
-		_, err := uc.users.GetByNickname(ctx, req.Nickname)
-		if err != nil && !errors.Is(err, contracts.ErrUserNotFound) {
+		validationErr := &contracts.ValidationError{}
+		validationErr.Add("nickname", uc.nicknames.Check(req.Nickname))
+		if err := validationErr.Err(); err != nil {
 			return models.User{}, err
 		}
-		if err == nil { // there is already a user with the desired nickname
-			return models.User{}, contracts.ErrUserAlreadyExists
+
+		if err := checkNicknameAvailable(ctx, uc.users, req.Nickname, user.ID); err != nil {
+			return models.User{}, err
+		}
+
+		if !strings.EqualFold(req.Nickname, user.Nickname) {
+			if err := uc.checkNicknameChange(ctx, req.Nickname, user.ID); err != nil {
+				return models.User{}, err
+			}
 		}
 
 		user.Nickname = req.Nickname
@@ -80,12 +91,27 @@ func (uc *UserUpdaterImpl) patchUserModel(ctx context.Context, user models.User,
 		user.BornAt = req.BirthDate
 	}
 
+	// Height and weight are given in the requested units, or in the user's current ones when none are requested.
+	if req.Units != "" {
+		user.Units = req.Units
+	}
+	if user.Units == "" {
+		user.Units = models.DefaultUnits
+	}
+
+	validationErr := &contracts.ValidationError{}
 	if req.Weight != 0 {
-		user.Weight = req.Weight
+		validationErr.Add("weight", models.CheckWeight(req.Weight, user.Units))
+		user.Weight = models.WeightToMetric(req.Weight, user.Units)
 	}
 
 	if req.Height != 0 {
-		user.Height = req.Height
+		validationErr.Add("height", models.CheckHeight(req.Height, user.Units))
+		user.Height = models.HeightToMetric(req.Height, user.Units)
+	}
+
+	if err := validationErr.Err(); err != nil {
+		return models.User{}, err
 	}
 
 	if req.Latitude != nil && req.Longitude != nil {
@@ -93,5 +119,35 @@ func (uc *UserUpdaterImpl) patchUserModel(ctx context.Context, user models.User,
 		user.Longitude = *req.Longitude
 	}
 
+	if req.Language != "" {
+		user.Language = req.Language
+	}
+
 	return user, nil
 }
+
+// checkNicknameChange enforces the nickname policy's change interval and hold period. Users can take back their own
+// released nicknames, and changes that only affect case are not limited.
+func (uc *UserUpdaterImpl) checkNicknameChange(ctx context.Context, nickname string, userID string) error {
+	now := time.Now()
+	if uc.nicknames.ChangeInterval > 0 {
+		changes, err := uc.nicknameChanges.GetByUser(ctx, userID, now.Add(-uc.nicknames.ChangeInterval))
+		if err != nil {
+			return err
+		}
+		if len(changes) > 0 {
+			return contracts.ErrNicknameChangeTooSoon
+		}
+	}
+
+	if uc.nicknames.HoldPeriod > 0 {
+		changes, err := uc.nicknameChanges.GetByNickname(ctx, nickname, now.Add(-uc.nicknames.HoldPeriod))
+		if err != nil {
+			return err
+		}
+		if len(changes) > 0 && changes[0].UserID != userID {
+			return contracts.ErrNicknameOnHold
+		}
+	}
+	return nil
+}
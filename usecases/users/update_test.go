@@ -30,7 +30,7 @@ func TestUpdateUserDoesNotExistError(t *testing.T) {
 
 	metricsRepo := new(mocks.Metrics)
 	metricsRepo.On("Create")
-	userUc := NewUserUpdaterImpl(userRepo, metricsRepo)
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), metricsRepo, models.NicknamePolicy{})
 	_, err := userUc.UpdateUser(ctx, req)
 	assert.Error(t, err)
 }
@@ -62,7 +62,7 @@ func TestUpdateUserPatchModelError(t *testing.T) {
 
 	metricsRepo := new(mocks.Metrics)
 	metricsRepo.On("Create")
-	userUc := NewUserUpdaterImpl(userRepo, metricsRepo)
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), metricsRepo, models.NicknamePolicy{})
 	_, err := userUc.UpdateUser(ctx, req)
 
 	//then
@@ -90,7 +90,7 @@ func TestUpdateUserRepoError(t *testing.T) {
 	userRepo.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, contracts.ErrUserNotFound)
 	metricsRepo := new(mocks.Metrics)
 	metricsRepo.On("Create")
-	userUc := NewUserUpdaterImpl(userRepo, metricsRepo)
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), metricsRepo, models.NicknamePolicy{})
 	patchedUser, _ := userUc.patchUserModel(ctx, outdatedUser, req)
 	userRepo.On("Update", ctx, patchedUser).Return(models.User{}, errors.New("repo error"))
 	_, err := userUc.UpdateUser(ctx, req)
@@ -120,7 +120,7 @@ func TestUpdateUserOk(t *testing.T) {
 	userRepo.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, contracts.ErrUserNotFound)
 	metricsRepo := new(mocks.Metrics)
 	metricsRepo.On("Create")
-	userUc := NewUserUpdaterImpl(userRepo, metricsRepo)
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), metricsRepo, models.NicknamePolicy{})
 	patchedUser, _ := userUc.patchUserModel(ctx, outdatedUser, req)
 	userRepo.On("Update", ctx, patchedUser).Return(models.User{}, nil)
 	_, err := userUc.UpdateUser(ctx, req)
@@ -155,12 +155,13 @@ func TestPatchUserModelOk(t *testing.T) {
 		BirthDate:   time.Now().Add(1),
 		Weight:      200,
 		Height:      200,
+		Language:    models.LanguageSpanish,
 	}
 
 	userRepo.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, contracts.ErrUserNotFound)
 	metricsRepo := new(mocks.Metrics)
 	metricsRepo.On("Create")
-	userUc := NewUserUpdaterImpl(userRepo, metricsRepo)
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), metricsRepo, models.NicknamePolicy{})
 	updatedUser, err := userUc.patchUserModel(ctx, outdatedUser, req)
 
 	assert.NoError(t, err)
@@ -170,6 +171,7 @@ func TestPatchUserModelOk(t *testing.T) {
 	assert.Equal(t, updatedUser.BornAt, req.BirthDate)
 	assert.Equal(t, updatedUser.Weight, req.Weight)
 	assert.Equal(t, updatedUser.Height, req.Height)
+	assert.Equal(t, updatedUser.Language, req.Language)
 }
 
 func TestPatchUserModelRepoError(t *testing.T) {
@@ -190,8 +192,37 @@ func TestPatchUserModelRepoError(t *testing.T) {
 	userRepo.On("GetByNickname", ctx, req.Nickname).Return(models.User{}, errors.New("repo error"))
 	metricsRepo := new(mocks.Metrics)
 	metricsRepo.On("Create")
-	userUc := NewUserUpdaterImpl(userRepo, metricsRepo)
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), metricsRepo, models.NicknamePolicy{})
 	_, err := userUc.patchUserModel(ctx, outdatedUser, req)
 
 	assert.Error(t, err)
 }
+
+func TestPatchUserModelImperialUnits(t *testing.T) {
+	userRepo := new(mocks.Users)
+	ctx := context.Background()
+	outdatedUser := models.User{ID: "h0l4", Height: 180, Weight: 80, Units: models.UnitsMetric}
+	req := users.UpdateUserRequest{ID: "h0l4", Weight: 180, Height: 71, Units: models.UnitsImperial}
+
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), new(mocks.Metrics), models.NicknamePolicy{})
+	updatedUser, err := userUc.patchUserModel(ctx, outdatedUser, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.UnitsImperial, updatedUser.Units)
+	assert.Equal(t, 81.65, updatedUser.Weight)
+	assert.Equal(t, 180.34, updatedUser.Height)
+}
+
+func TestPatchUserModelBodyDataOutOfRange(t *testing.T) {
+	userRepo := new(mocks.Users)
+	ctx := context.Background()
+	outdatedUser := models.User{ID: "h0l4", Height: 180, Weight: 80, Units: models.UnitsImperial}
+	req := users.UpdateUserRequest{ID: "h0l4", Height: 180}
+
+	userUc := NewUserUpdaterImpl(userRepo, new(mocks.NicknameChanges), new(mocks.Metrics), models.NicknamePolicy{})
+	_, err := userUc.patchUserModel(ctx, outdatedUser, req)
+
+	var validationErr *contracts.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "height", validationErr.Fields[0].Field)
+}
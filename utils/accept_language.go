@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PreferredLanguage returns the supported language with the highest weight in an Accept-Language header,
+// comparing only primary subtags (es-AR matches es). It returns "" if none of them is supported.
+func PreferredLanguage(acceptLanguage string, supported map[string]struct{}) string {
+	type weightedLanguage struct {
+		language string
+		weight   float64
+	}
+
+	var candidates []weightedLanguage
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+
+		weight := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				parsed, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err == nil {
+					weight = parsed
+				}
+			}
+		}
+
+		primary := strings.SplitN(tag, "-", 2)[0]
+		candidates = append(candidates, weightedLanguage{language: primary, weight: weight})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].weight > candidates[j].weight
+	})
+
+	for _, candidate := range candidates {
+		if candidate.weight <= 0 {
+			break
+		}
+		if _, ok := supported[candidate.language]; ok {
+			return candidate.language
+		}
+	}
+	return ""
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testLanguages = map[string]struct{}{"en": {}, "es": {}}
+
+func TestPreferredLanguage_Empty(t *testing.T) {
+	assert.Equal(t, "", PreferredLanguage("", testLanguages))
+}
+
+func TestPreferredLanguage_Unsupported(t *testing.T) {
+	assert.Equal(t, "", PreferredLanguage("fr-FR, de;q=0.8", testLanguages))
+}
+
+func TestPreferredLanguage_MatchesPrimarySubtag(t *testing.T) {
+	assert.Equal(t, "es", PreferredLanguage("es-AR", testLanguages))
+}
+
+func TestPreferredLanguage_UsesWeights(t *testing.T) {
+	assert.Equal(t, "en", PreferredLanguage("fr;q=0.9, es;q=0.5, en-US;q=0.8", testLanguages))
+}
+
+func TestPreferredLanguage_IgnoresZeroWeight(t *testing.T) {
+	assert.Equal(t, "", PreferredLanguage("es;q=0, fr", testLanguages))
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	MinPictureSide      = 128
+	MaxPictureSide      = 4096
+	canonicalPictureMax = 1024
+)
+
+var AllowedPictureTypes = map[string]struct{}{
+	"image/jpeg": {},
+	"image/png":  {},
+	"image/gif":  {},
+}
+
+var (
+	ErrUnsupportedPictureType = errors.New("must be a JPEG, PNG or GIF image")
+	ErrInvalidPictureSize     = fmt.Errorf("must be between %dx%d and %dx%d pixels", MinPictureSide, MinPictureSide, MaxPictureSide, MaxPictureSide)
+)
+
+// ProcessPicture validates an uploaded picture and re-encodes it as PNG, downscaled to fit canonicalPictureMax.
+// Square thumbnails of each of thumbnailSizes are cropped from its center. The result is keyed by the
+// thumbnailSizes keys, plus original for the re-encoded picture.
+func ProcessPicture(data []byte, original string, thumbnailSizes map[string]int) (map[string][]byte, error) {
+	if _, ok := AllowedPictureTypes[http.DetectContentType(data)]; !ok {
+		return nil, ErrUnsupportedPictureType
+	}
+
+	// checking the header first avoids decoding huge pictures
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedPictureType
+	}
+	if config.Width < MinPictureSide || config.Height < MinPictureSide || config.Width > MaxPictureSide || config.Height > MaxPictureSide {
+		return nil, ErrInvalidPictureSize
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedPictureType
+	}
+	picture := toRGBA(decoded)
+	bounds := picture.Bounds()
+
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > canonicalPictureMax || height > canonicalPictureMax {
+		if width > height {
+			width, height = canonicalPictureMax, height*canonicalPictureMax/width
+		} else {
+			width, height = width*canonicalPictureMax/height, canonicalPictureMax
+		}
+	}
+
+	pictures := make(map[string][]byte, len(thumbnailSizes)+1)
+	pictures[original], err = encodePNG(resize(picture, bounds, width, height))
+	if err != nil {
+		return nil, err
+	}
+
+	side := bounds.Dx()
+	if bounds.Dy() < side {
+		side = bounds.Dy()
+	}
+	center := image.Rect(0, 0, side, side).Add(image.Pt((bounds.Dx()-side)/2, (bounds.Dy()-side)/2))
+	for name, size := range thumbnailSizes {
+		pictures[name], err = encodePNG(resize(picture, center, size, size))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pictures, nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+	return rgba
+}
+
+// resize scales the area of src to width x height, averaging the source pixels under each resulting pixel.
+func resize(src *image.RGBA, area image.Rectangle, width int, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := area.Min.Y + y*area.Dy()/height
+		y1 := area.Min.Y + (y+1)*area.Dy()/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := area.Min.X + x*area.Dx()/width
+			x1 := area.Min.X + (x+1)*area.Dx()/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var sum [4]uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					i := src.PixOffset(sx, sy)
+					for c := 0; c < 4; c++ {
+						sum[c] += uint64(src.Pix[i+c])
+					}
+				}
+			}
+
+			count := uint64((y1 - y0) * (x1 - x0))
+			j := dst.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				dst.Pix[j+c] = uint8(sum[c] / count)
+			}
+		}
+	}
+	return dst
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
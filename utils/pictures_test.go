@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPicture(t *testing.T, width int, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, height/2, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestProcessPicture_UnsupportedType(t *testing.T) {
+	_, err := ProcessPicture([]byte("definitely not a picture"), "original", map[string]int{"small": 64})
+	assert.ErrorIs(t, err, ErrUnsupportedPictureType)
+}
+
+func TestProcessPicture_InvalidSize(t *testing.T) {
+	_, err := ProcessPicture(testPicture(t, 100, 300), "original", map[string]int{"small": 64})
+	assert.ErrorIs(t, err, ErrInvalidPictureSize)
+}
+
+func TestProcessPicture_Ok(t *testing.T) {
+	pictures, err := ProcessPicture(testPicture(t, 2048, 512), "original", map[string]int{"small": 64, "large": 256})
+	assert.NoError(t, err)
+	assert.Len(t, pictures, 3)
+
+	expectedSizes := map[string]image.Point{"original": {X: 1024, Y: 256}, "small": {X: 64, Y: 64}, "large": {X: 256, Y: 256}}
+	for name, size := range expectedSizes {
+		config, format, err := image.DecodeConfig(bytes.NewReader(pictures[name]))
+		assert.NoError(t, err)
+		assert.Equal(t, "png", format)
+		assert.Equal(t, size, image.Pt(config.Width, config.Height), name)
+	}
+}
@@ -6,6 +6,10 @@ import (
 	"github.com/sams96/rgeo"
 )
 
+// LocationDatasetVersion identifies the dataset used to resolve locations. Bump it when the dataset changes, so that
+// stored locations get resolved again.
+const LocationDatasetVersion = 1
+
 type ReverseLocator struct {
 	rGeo *rgeo.Rgeo
 }